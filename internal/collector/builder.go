@@ -19,17 +19,25 @@ package collector
 import (
 	"sort"
 	"strings"
+	"time"
 
 	"k8s.io/klog"
 
 	"golang.org/x/net/context"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
 	apps "k8s.io/api/apps/v1beta1"
 	autoscaling "k8s.io/api/autoscaling/v2beta1"
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	certv1beta1 "k8s.io/api/certificates/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
+	storagev1 "k8s.io/api/storage/v1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	coll "k8s.io/kube-state-metrics/pkg/collector"
@@ -51,6 +59,20 @@ type Builder struct {
 	ctx               context.Context
 	enabledCollectors []string
 	whiteBlackList    whiteBlackLister
+	eventReasons      []string
+
+	componentStatusPollInterval time.Duration
+
+	// owners is shared by the collectors of intermediate owners, which fill
+	// it, and the pod collector, which resolves pod controllers with it.
+	owners *ownerIndex
+
+	podNodeLabels             []string
+	podNamespaceLabels        []string
+	podLabelEnrichmentMetrics []string
+	// podLabels is shared by the node and namespace collectors, which fill
+	// it, and the pod collector, which copies the labels onto pod metrics.
+	podLabels *podLabelIndex
 }
 
 // NewBuilder returns a new builder.
@@ -58,7 +80,9 @@ func NewBuilder(
 	ctx context.Context,
 ) *Builder {
 	return &Builder{
-		ctx: ctx,
+		ctx:                         ctx,
+		componentStatusPollInterval: options.DefaultComponentStatusPollInterval,
+		owners:                      newOwnerIndex(),
 	}
 }
 
@@ -88,6 +112,48 @@ func (b *Builder) WithWhiteBlackList(l whiteBlackLister) {
 	b.whiteBlackList = l
 }
 
+// WithEventReasons sets the allow list of event reasons aggregated by the
+// events collector.
+func (b *Builder) WithEventReasons(r []string) {
+	b.eventReasons = r
+}
+
+// WithComponentStatusPollInterval sets the interval at which the
+// componentstatuses collector polls the API server.
+func (b *Builder) WithComponentStatusPollInterval(i time.Duration) {
+	b.componentStatusPollInterval = i
+}
+
+// WithPodNodeLabels sets the node labels copied onto the metrics of the pods
+// running on the node.
+func (b *Builder) WithPodNodeLabels(l []string) {
+	b.podNodeLabels = l
+}
+
+// WithPodNamespaceLabels sets the namespace labels copied onto the metrics of
+// the pods in the namespace.
+func (b *Builder) WithPodNamespaceLabels(l []string) {
+	b.podNamespaceLabels = l
+}
+
+// WithPodLabelEnrichmentMetrics sets the pod metrics node and namespace labels
+// are copied onto.
+func (b *Builder) WithPodLabelEnrichmentMetrics(m []string) {
+	b.podLabelEnrichmentMetrics = m
+}
+
+// sharedPodLabelIndex returns the index of node and namespace labels copied
+// onto pod metrics, or nil if no labels are to be copied.
+func (b *Builder) sharedPodLabelIndex() *podLabelIndex {
+	if len(b.podNodeLabels) == 0 && len(b.podNamespaceLabels) == 0 {
+		return nil
+	}
+	if b.podLabels == nil {
+		b.podLabels = newPodLabelIndex(b.podNodeLabels, b.podNamespaceLabels)
+	}
+	return b.podLabels
+}
+
 // Build initializes and registers all enabled collectors.
 func (b *Builder) Build() []*coll.Collector {
 	if b.whiteBlackList == nil {
@@ -112,27 +178,114 @@ func (b *Builder) Build() []*coll.Collector {
 }
 
 var availableCollectors = map[string]func(f *Builder) *coll.Collector{
-	"configmaps":               func(b *Builder) *coll.Collector { return b.buildConfigMapCollector() },
-	"cronjobs":                 func(b *Builder) *coll.Collector { return b.buildCronJobCollector() },
-	"daemonsets":               func(b *Builder) *coll.Collector { return b.buildDaemonSetCollector() },
-	"deployments":              func(b *Builder) *coll.Collector { return b.buildDeploymentCollector() },
-	"endpoints":                func(b *Builder) *coll.Collector { return b.buildEndpointsCollector() },
-	"horizontalpodautoscalers": func(b *Builder) *coll.Collector { return b.buildHPACollector() },
-	"ingresses":                func(b *Builder) *coll.Collector { return b.buildIngressCollector() },
-	"jobs":                     func(b *Builder) *coll.Collector { return b.buildJobCollector() },
-	"limitranges":              func(b *Builder) *coll.Collector { return b.buildLimitRangeCollector() },
-	"namespaces":               func(b *Builder) *coll.Collector { return b.buildNamespaceCollector() },
-	"nodes":                    func(b *Builder) *coll.Collector { return b.buildNodeCollector() },
-	"persistentvolumeclaims":   func(b *Builder) *coll.Collector { return b.buildPersistentVolumeClaimCollector() },
-	"persistentvolumes":        func(b *Builder) *coll.Collector { return b.buildPersistentVolumeCollector() },
-	"poddisruptionbudgets":     func(b *Builder) *coll.Collector { return b.buildPodDisruptionBudgetCollector() },
-	"pods":                     func(b *Builder) *coll.Collector { return b.buildPodCollector() },
-	"replicasets":              func(b *Builder) *coll.Collector { return b.buildReplicaSetCollector() },
-	"replicationcontrollers":   func(b *Builder) *coll.Collector { return b.buildReplicationControllerCollector() },
-	"resourcequotas":           func(b *Builder) *coll.Collector { return b.buildResourceQuotaCollector() },
-	"secrets":                  func(b *Builder) *coll.Collector { return b.buildSecretCollector() },
-	"services":                 func(b *Builder) *coll.Collector { return b.buildServiceCollector() },
-	"statefulsets":             func(b *Builder) *coll.Collector { return b.buildStatefulSetCollector() },
+	"certificatesigningrequests":      func(b *Builder) *coll.Collector { return b.buildCSRCollector() },
+	"clusterrolebindings":             func(b *Builder) *coll.Collector { return b.buildClusterRoleBindingCollector() },
+	"clusterroles":                    func(b *Builder) *coll.Collector { return b.buildClusterRoleCollector() },
+	"componentstatuses":               func(b *Builder) *coll.Collector { return b.buildComponentStatusCollector() },
+	"configmaps":                      func(b *Builder) *coll.Collector { return b.buildConfigMapCollector() },
+	"cronjobs":                        func(b *Builder) *coll.Collector { return b.buildCronJobCollector() },
+	"daemonsets":                      func(b *Builder) *coll.Collector { return b.buildDaemonSetCollector() },
+	"deployments":                     func(b *Builder) *coll.Collector { return b.buildDeploymentCollector() },
+	"endpoints":                       func(b *Builder) *coll.Collector { return b.buildEndpointsCollector() },
+	"events":                          func(b *Builder) *coll.Collector { return b.buildEventCollector() },
+	"horizontalpodautoscalers":        func(b *Builder) *coll.Collector { return b.buildHPACollector() },
+	"ingresses":                       func(b *Builder) *coll.Collector { return b.buildIngressCollector() },
+	"jobs":                            func(b *Builder) *coll.Collector { return b.buildJobCollector() },
+	"limitranges":                     func(b *Builder) *coll.Collector { return b.buildLimitRangeCollector() },
+	"mutatingwebhookconfigurations":   func(b *Builder) *coll.Collector { return b.buildMutatingWebhookConfigurationCollector() },
+	"namespaces":                      func(b *Builder) *coll.Collector { return b.buildNamespaceCollector() },
+	"networkpolicies":                 func(b *Builder) *coll.Collector { return b.buildNetworkPolicyCollector() },
+	"nodes":                           func(b *Builder) *coll.Collector { return b.buildNodeCollector() },
+	"persistentvolumeclaims":          func(b *Builder) *coll.Collector { return b.buildPersistentVolumeClaimCollector() },
+	"persistentvolumes":               func(b *Builder) *coll.Collector { return b.buildPersistentVolumeCollector() },
+	"poddisruptionbudgets":            func(b *Builder) *coll.Collector { return b.buildPodDisruptionBudgetCollector() },
+	"pods":                            func(b *Builder) *coll.Collector { return b.buildPodCollector() },
+	"priorityclasses":                 func(b *Builder) *coll.Collector { return b.buildPriorityClassCollector() },
+	"replicasets":                     func(b *Builder) *coll.Collector { return b.buildReplicaSetCollector() },
+	"replicationcontrollers":          func(b *Builder) *coll.Collector { return b.buildReplicationControllerCollector() },
+	"resourcequotas":                  func(b *Builder) *coll.Collector { return b.buildResourceQuotaCollector() },
+	"rolebindings":                    func(b *Builder) *coll.Collector { return b.buildRoleBindingCollector() },
+	"roles":                           func(b *Builder) *coll.Collector { return b.buildRoleCollector() },
+	"secrets":                         func(b *Builder) *coll.Collector { return b.buildSecretCollector() },
+	"serviceaccounts":                 func(b *Builder) *coll.Collector { return b.buildServiceAccountCollector() },
+	"services":                        func(b *Builder) *coll.Collector { return b.buildServiceCollector() },
+	"statefulsets":                    func(b *Builder) *coll.Collector { return b.buildStatefulSetCollector() },
+	"storageclasses":                  func(b *Builder) *coll.Collector { return b.buildStorageClassCollector() },
+	"validatingwebhookconfigurations": func(b *Builder) *coll.Collector { return b.buildValidatingWebhookConfigurationCollector() },
+	"volumeattachments":               func(b *Builder) *coll.Collector { return b.buildVolumeAttachmentCollector() },
+}
+
+func (b *Builder) buildCSRCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, csrMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &certv1beta1.CertificateSigningRequest{}, store, b.namespaces, createCSRListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildClusterRoleCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, clusterRoleMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &rbacv1.ClusterRole{}, store, b.namespaces, createClusterRoleListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildClusterRoleBindingCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, clusterRoleBindingMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &rbacv1.ClusterRoleBinding{}, store, b.namespaces, createClusterRoleBindingListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildComponentStatusCollector() *coll.Collector {
+	// wait.Until does not wait between polls for non-positive intervals.
+	if b.componentStatusPollInterval <= 0 {
+		panic("componentStatusPollInterval should be positive")
+	}
+
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, componentStatusMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	filteredPollMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, componentStatusPollMetricFamilies)
+	composedPollMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredPollMetricFamilies)
+
+	pollFamilyHeaders := metric.ExtractMetricFamilyHeaders(filteredPollMetricFamilies)
+
+	store := newComponentStatusStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+		pollFamilyHeaders,
+		composedPollMetricGenFuncs,
+		b.componentStatusPollInterval,
+	)
+	go store.Run(b.kubeClient, b.ctx.Done())
+
+	return coll.NewCollector(store)
 }
 
 func (b *Builder) buildConfigMapCollector() *coll.Collector {
@@ -210,6 +363,22 @@ func (b *Builder) buildEndpointsCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildEventCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, eventMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := newEventStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+		b.eventReasons,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Event{}, store, b.namespaces, createEventListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildHPACollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, hpaMetricFamilies)
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
@@ -250,7 +419,7 @@ func (b *Builder) buildJobCollector() *coll.Collector {
 		familyHeaders,
 		composedMetricGenFuncs,
 	)
-	reflectorPerNamespace(b.ctx, b.kubeClient, &batchv1.Job{}, store, b.namespaces, createJobListWatch)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &batchv1.Job{}, newOwnerIndexStore(store, b.owners, "Job"), b.namespaces, createJobListWatch)
 
 	return coll.NewCollector(store)
 }
@@ -270,6 +439,21 @@ func (b *Builder) buildLimitRangeCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildMutatingWebhookConfigurationCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, mutatingWebhookConfigurationMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &admissionregistration.MutatingWebhookConfiguration{}, store, b.namespaces, createMutatingWebhookConfigurationListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildNamespaceCollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, namespaceMetricFamilies)
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
@@ -280,7 +464,26 @@ func (b *Builder) buildNamespaceCollector() *coll.Collector {
 		familyHeaders,
 		composedMetricGenFuncs,
 	)
-	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Namespace{}, store, b.namespaces, createNamespaceListWatch)
+	var reflectorStore cache.Store = store
+	if index := b.sharedPodLabelIndex(); index != nil && len(b.podNamespaceLabels) > 0 {
+		reflectorStore = newPodLabelIndexStore(store, index, podLabelIndexKindNamespace)
+	}
+	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Namespace{}, reflectorStore, b.namespaces, createNamespaceListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildNetworkPolicyCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, networkPolicyMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &networkingv1.NetworkPolicy{}, store, b.namespaces, createNetworkPolicyListWatch)
 
 	return coll.NewCollector(store)
 }
@@ -295,7 +498,11 @@ func (b *Builder) buildNodeCollector() *coll.Collector {
 		familyHeaders,
 		composedMetricGenFuncs,
 	)
-	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Node{}, store, b.namespaces, createNodeListWatch)
+	var reflectorStore cache.Store = store
+	if index := b.sharedPodLabelIndex(); index != nil && len(b.podNodeLabels) > 0 {
+		reflectorStore = newPodLabelIndexStore(store, index, podLabelIndexKindNode)
+	}
+	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Node{}, reflectorStore, b.namespaces, createNodeListWatch)
 
 	return coll.NewCollector(store)
 }
@@ -345,6 +552,21 @@ func (b *Builder) buildPodDisruptionBudgetCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildPriorityClassCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, priorityClassMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &schedulingv1beta1.PriorityClass{}, store, b.namespaces, createPriorityClassListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildReplicaSetCollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, replicaSetMetricFamilies)
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
@@ -355,7 +577,7 @@ func (b *Builder) buildReplicaSetCollector() *coll.Collector {
 		familyHeaders,
 		composedMetricGenFuncs,
 	)
-	reflectorPerNamespace(b.ctx, b.kubeClient, &extensions.ReplicaSet{}, store, b.namespaces, createReplicaSetListWatch)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &extensions.ReplicaSet{}, newOwnerIndexStore(store, b.owners, "ReplicaSet"), b.namespaces, createReplicaSetListWatch)
 
 	return coll.NewCollector(store)
 }
@@ -390,6 +612,36 @@ func (b *Builder) buildResourceQuotaCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildRoleCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, roleMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &rbacv1.Role{}, store, b.namespaces, createRoleListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildRoleBindingCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, roleBindingMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &rbacv1.RoleBinding{}, store, b.namespaces, createRoleBindingListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildSecretCollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, secretMetricFamilies)
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
@@ -405,6 +657,21 @@ func (b *Builder) buildSecretCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildServiceAccountCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, serviceAccountMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.ServiceAccount{}, store, b.namespaces, createServiceAccountListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildServiceCollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, serviceMetricFamilies)
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
@@ -435,8 +702,70 @@ func (b *Builder) buildStatefulSetCollector() *coll.Collector {
 	return coll.NewCollector(store)
 }
 
+func (b *Builder) buildStorageClassCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, storageClassMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &storagev1.StorageClass{}, store, b.namespaces, createStorageClassListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildValidatingWebhookConfigurationCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, validatingWebhookConfigurationMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &admissionregistration.ValidatingWebhookConfiguration{}, store, b.namespaces, createValidatingWebhookConfigurationListWatch)
+
+	return coll.NewCollector(store)
+}
+
+func (b *Builder) buildVolumeAttachmentCollector() *coll.Collector {
+	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, volumeAttachmentMetricFamilies)
+	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
+
+	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
+
+	store := metricsstore.NewMetricsStore(
+		familyHeaders,
+		composedMetricGenFuncs,
+	)
+	reflectorPerNamespace(b.ctx, b.kubeClient, &storagev1beta1.VolumeAttachment{}, store, b.namespaces, createVolumeAttachmentListWatch)
+
+	return coll.NewCollector(store)
+}
+
 func (b *Builder) buildPodCollector() *coll.Collector {
 	filteredMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, podMetricFamilies)
+
+	// The metric families selected for label enrichment are exposed by the
+	// podLabelEnrichmentStore instead of the MetricsStore.
+	index := b.sharedPodLabelIndex()
+	enrichedMetricFamilies := []metric.FamilyGenerator{}
+	if index != nil {
+		plainMetricFamilies := []metric.FamilyGenerator{}
+		for _, f := range filteredMetricFamilies {
+			if isPodLabelEnrichmentMetric(f.Name, b.podLabelEnrichmentMetrics) {
+				enrichedMetricFamilies = append(enrichedMetricFamilies, f)
+			} else {
+				plainMetricFamilies = append(plainMetricFamilies, f)
+			}
+		}
+		filteredMetricFamilies = plainMetricFamilies
+	}
+
 	composedMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredMetricFamilies)
 
 	familyHeaders := metric.ExtractMetricFamilyHeaders(filteredMetricFamilies)
@@ -445,9 +774,45 @@ func (b *Builder) buildPodCollector() *coll.Collector {
 		familyHeaders,
 		composedMetricGenFuncs,
 	)
-	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Pod{}, store, b.namespaces, createPodListWatch)
 
-	return coll.NewCollector(store)
+	filteredControllerMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, podControllerMetricFamilies)
+	composedControllerMetricGenFuncs := metric.ComposeMetricGenFuncs(filteredControllerMetricFamilies)
+
+	controllerFamilyHeaders := metric.ExtractMetricFamilyHeaders(filteredControllerMetricFamilies)
+
+	controllerStore := newPodControllerStore(
+		store,
+		b.owners,
+		controllerFamilyHeaders,
+		composedControllerMetricGenFuncs,
+	)
+
+	var reflectorStore podStore = controllerStore
+	if len(enrichedMetricFamilies) > 0 {
+		reflectorStore = newPodLabelEnrichmentStore(reflectorStore, index, enrichedMetricFamilies)
+	}
+
+	for _, a := range []struct {
+		families      []metric.FamilyGenerator
+		aggregateFunc func(*v1.Pod) *podAggregate
+	}{
+		{namespaceAggregateMetricFamilies, namespaceAggregateOf},
+		{nodeAggregateMetricFamilies, nodeAggregateOf},
+	} {
+		filteredAggregateMetricFamilies := metric.FilterMetricFamilies(b.whiteBlackList, a.families)
+		if len(filteredAggregateMetricFamilies) > 0 {
+			reflectorStore = newPodAggregateStore(
+				reflectorStore,
+				a.aggregateFunc,
+				metric.ExtractMetricFamilyHeaders(filteredAggregateMetricFamilies),
+				metric.ComposeMetricGenFuncs(filteredAggregateMetricFamilies),
+			)
+		}
+	}
+
+	reflectorPerNamespace(b.ctx, b.kubeClient, &v1.Pod{}, reflectorStore, b.namespaces, createPodListWatch)
+
+	return coll.NewCollector(reflectorStore)
 }
 
 // reflectorPerNamespace creates a Kubernetes client-go reflector with the given
@@ -0,0 +1,219 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	certv1beta1 "k8s.io/api/certificates/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descCSRLabelsName          = "kube_certificatesigningrequest_labels"
+	descCSRLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descCSRLabelsDefaultLabels = []string{"certificatesigningrequest"}
+
+	csrMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_certificatesigningrequest_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !csr.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(csr.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descCSRLabelsName,
+			Type: metric.Gauge,
+			Help: descCSRLabelsHelp,
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(csr.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_certificatesigningrequest_info",
+			Type: metric.Gauge,
+			Help: "Information about certificatesigningrequest.",
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"username"},
+							LabelValues: []string{csr.Spec.Username},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_certificatesigningrequest_usage",
+			Type: metric.Gauge,
+			Help: "Key usage requested in the certificatesigningrequest.",
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				ms := make([]*metric.Metric, len(csr.Spec.Usages))
+
+				for i, u := range csr.Spec.Usages {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"usage"},
+						LabelValues: []string{string(u)},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_certificatesigningrequest_condition",
+			Type: metric.Gauge,
+			Help: "The approval condition of the certificatesigningrequest.",
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				approved, denied := csrApprovalStatus(csr)
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"condition"},
+							LabelValues: []string{"approved"},
+							Value:       boolFloat64(approved),
+						},
+						{
+							LabelKeys:   []string{"condition"},
+							LabelValues: []string{"denied"},
+							Value:       boolFloat64(denied),
+						},
+						{
+							LabelKeys:   []string{"condition"},
+							LabelValues: []string{"pending"},
+							Value:       boolFloat64(!approved && !denied),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_certificatesigningrequest_cert_expiration_timestamp_seconds",
+			Type: metric.Gauge,
+			Help: "Unix expiration timestamp of the certificate issued for the certificatesigningrequest.",
+			GenerateFunc: wrapCSRFunc(func(csr *certv1beta1.CertificateSigningRequest) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if len(csr.Status.Certificate) == 0 {
+					return &metric.Family{
+						Metrics: ms,
+					}
+				}
+
+				cert, err := parseCertificate(csr.Status.Certificate)
+				if err == nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(cert.NotAfter.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+)
+
+// csrApprovalStatus returns whether the certificatesigningrequest has been
+// approved or denied. A request with neither condition is pending.
+func csrApprovalStatus(csr *certv1beta1.CertificateSigningRequest) (approved bool, denied bool) {
+	for _, c := range csr.Status.Conditions {
+		switch c.Type {
+		case certv1beta1.CertificateApproved:
+			approved = true
+		case certv1beta1.CertificateDenied:
+			denied = true
+		}
+	}
+	return approved, denied
+}
+
+// parseCertificate parses the first PEM encoded certificate in data.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+func wrapCSRFunc(f func(*certv1beta1.CertificateSigningRequest) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		csr := obj.(*certv1beta1.CertificateSigningRequest)
+
+		metricFamily := f(csr)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descCSRLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{csr.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createCSRListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.CertificatesV1beta1().CertificateSigningRequests().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.CertificatesV1beta1().CertificateSigningRequests().Watch(opts)
+		},
+	}
+}
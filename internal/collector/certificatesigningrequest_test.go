@@ -0,0 +1,153 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	certv1beta1 "k8s.io/api/certificates/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+// newTestCertificate returns a PEM encoded self-signed certificate expiring
+// at notAfter.
+func newTestCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "system:node:node1"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCSRCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_certificatesigningrequest_created Unix creation timestamp
+		# TYPE kube_certificatesigningrequest_created gauge
+		# HELP kube_certificatesigningrequest_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_certificatesigningrequest_labels gauge
+		# HELP kube_certificatesigningrequest_info Information about certificatesigningrequest.
+		# TYPE kube_certificatesigningrequest_info gauge
+		# HELP kube_certificatesigningrequest_usage Key usage requested in the certificatesigningrequest.
+		# TYPE kube_certificatesigningrequest_usage gauge
+		# HELP kube_certificatesigningrequest_condition The approval condition of the certificatesigningrequest.
+		# TYPE kube_certificatesigningrequest_condition gauge
+		# HELP kube_certificatesigningrequest_cert_expiration_timestamp_seconds Unix expiration timestamp of the certificate issued for the certificatesigningrequest.
+		# TYPE kube_certificatesigningrequest_cert_expiration_timestamp_seconds gauge
+	`
+	cases := []generateMetricsTestCase{
+		// Verify a pending request.
+		{
+			Obj: &certv1beta1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "csr-pending",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Spec: certv1beta1.CertificateSigningRequestSpec{
+					Username: "system:node:node1",
+					Usages: []certv1beta1.KeyUsage{
+						certv1beta1.UsageDigitalSignature,
+						certv1beta1.UsageClientAuth,
+					},
+				},
+			},
+			Want: `
+				kube_certificatesigningrequest_created{certificatesigningrequest="csr-pending"} 1.5e+09
+				kube_certificatesigningrequest_labels{certificatesigningrequest="csr-pending"} 1
+				kube_certificatesigningrequest_info{certificatesigningrequest="csr-pending",username="system:node:node1"} 1
+				kube_certificatesigningrequest_usage{certificatesigningrequest="csr-pending",usage="client auth"} 1
+				kube_certificatesigningrequest_usage{certificatesigningrequest="csr-pending",usage="digital signature"} 1
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-pending",condition="approved"} 0
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-pending",condition="denied"} 0
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-pending",condition="pending"} 1
+`,
+		},
+		// Verify an approved and issued request.
+		{
+			Obj: &certv1beta1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "csr-approved",
+				},
+				Status: certv1beta1.CertificateSigningRequestStatus{
+					Conditions: []certv1beta1.CertificateSigningRequestCondition{
+						{Type: certv1beta1.CertificateApproved},
+					},
+					Certificate: newTestCertificate(t, time.Unix(1600000000, 0)),
+				},
+			},
+			Want: `
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-approved",condition="approved"} 1
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-approved",condition="denied"} 0
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-approved",condition="pending"} 0
+				kube_certificatesigningrequest_cert_expiration_timestamp_seconds{certificatesigningrequest="csr-approved"} 1.6e+09
+`,
+			MetricNames: []string{
+				"kube_certificatesigningrequest_condition",
+				"kube_certificatesigningrequest_cert_expiration_timestamp_seconds",
+			},
+		},
+		// Verify a denied request and that an unparsable certificate is skipped.
+		{
+			Obj: &certv1beta1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "csr-denied",
+				},
+				Status: certv1beta1.CertificateSigningRequestStatus{
+					Conditions: []certv1beta1.CertificateSigningRequestCondition{
+						{Type: certv1beta1.CertificateDenied},
+					},
+					Certificate: []byte("not a certificate"),
+				},
+			},
+			Want: `
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-denied",condition="approved"} 0
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-denied",condition="denied"} 1
+				kube_certificatesigningrequest_condition{certificatesigningrequest="csr-denied",condition="pending"} 0
+`,
+			MetricNames: []string{
+				"kube_certificatesigningrequest_condition",
+				"kube_certificatesigningrequest_cert_expiration_timestamp_seconds",
+			},
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(csrMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
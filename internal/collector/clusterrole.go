@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descClusterRoleLabelsName          = "kube_clusterrole_labels"
+	descClusterRoleLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descClusterRoleLabelsDefaultLabels = []string{"clusterrole"}
+
+	clusterRoleMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_clusterrole_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapClusterRoleFunc(func(r *rbacv1.ClusterRole) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !r.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(r.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descClusterRoleLabelsName,
+			Type: metric.Gauge,
+			Help: descClusterRoleLabelsHelp,
+			GenerateFunc: wrapClusterRoleFunc(func(r *rbacv1.ClusterRole) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(r.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_clusterrole_rules",
+			Type: metric.Gauge,
+			Help: "Number of policy rules of the clusterrole.",
+			GenerateFunc: wrapClusterRoleFunc(func(r *rbacv1.ClusterRole) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(r.Rules)),
+						},
+					},
+				}
+			}),
+		},
+	}
+)
+
+func wrapClusterRoleFunc(f func(*rbacv1.ClusterRole) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		clusterRole := obj.(*rbacv1.ClusterRole)
+
+		metricFamily := f(clusterRole)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descClusterRoleLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{clusterRole.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createClusterRoleListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.RbacV1().ClusterRoles().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.RbacV1().ClusterRoles().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestClusterRoleCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_clusterrole_created Unix creation timestamp
+		# TYPE kube_clusterrole_created gauge
+		# HELP kube_clusterrole_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_clusterrole_labels gauge
+		# HELP kube_clusterrole_rules Number of policy rules of the clusterrole.
+		# TYPE kube_clusterrole_rules gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &rbacv1.ClusterRole{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "reader",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+					Labels: map[string]string{
+						"team": "platform",
+					},
+				},
+				Rules: []rbacv1.PolicyRule{
+					{
+						APIGroups: []string{""},
+						Resources: []string{"pods"},
+						Verbs:     []string{"get", "list"},
+					},
+					{
+						APIGroups: []string{"apps"},
+						Resources: []string{"deployments"},
+						Verbs:     []string{"get"},
+					},
+				},
+			},
+			Want: `
+				kube_clusterrole_created{clusterrole="reader"} 1.5e+09
+				kube_clusterrole_labels{label_team="platform",clusterrole="reader"} 1
+				kube_clusterrole_rules{clusterrole="reader"} 2
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(clusterRoleMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
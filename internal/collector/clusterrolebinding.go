@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descClusterRoleBindingLabelsName          = "kube_clusterrolebinding_labels"
+	descClusterRoleBindingLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descClusterRoleBindingLabelsDefaultLabels = []string{"clusterrolebinding"}
+
+	clusterRoleBindingMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_clusterrolebinding_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapClusterRoleBindingFunc(func(b *rbacv1.ClusterRoleBinding) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !b.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(b.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descClusterRoleBindingLabelsName,
+			Type: metric.Gauge,
+			Help: descClusterRoleBindingLabelsHelp,
+			GenerateFunc: wrapClusterRoleBindingFunc(func(b *rbacv1.ClusterRoleBinding) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(b.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_clusterrolebinding_info",
+			Type: metric.Gauge,
+			Help: "Information about the role referenced by the clusterrolebinding.",
+			GenerateFunc: wrapClusterRoleBindingFunc(func(b *rbacv1.ClusterRoleBinding) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						roleRefMetric(b.RoleRef),
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_clusterrolebinding_subject",
+			Type: metric.Gauge,
+			Help: "Subject the clusterrolebinding grants the referenced role to.",
+			GenerateFunc: wrapClusterRoleBindingFunc(func(b *rbacv1.ClusterRoleBinding) *metric.Family {
+				return &metric.Family{
+					Metrics: subjectMetrics(b.Subjects),
+				}
+			}),
+		},
+	}
+)
+
+func wrapClusterRoleBindingFunc(f func(*rbacv1.ClusterRoleBinding) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		clusterRoleBinding := obj.(*rbacv1.ClusterRoleBinding)
+
+		metricFamily := f(clusterRoleBinding)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descClusterRoleBindingLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{clusterRoleBinding.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createClusterRoleBindingListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.RbacV1().ClusterRoleBindings().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.RbacV1().ClusterRoleBindings().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestClusterRoleBindingCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_clusterrolebinding_created Unix creation timestamp
+		# TYPE kube_clusterrolebinding_created gauge
+		# HELP kube_clusterrolebinding_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_clusterrolebinding_labels gauge
+		# HELP kube_clusterrolebinding_info Information about the role referenced by the clusterrolebinding.
+		# TYPE kube_clusterrolebinding_info gauge
+		# HELP kube_clusterrolebinding_subject Subject the clusterrolebinding grants the referenced role to.
+		# TYPE kube_clusterrolebinding_subject gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "readers",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "ClusterRole",
+					Name:     "reader",
+				},
+				Subjects: []rbacv1.Subject{
+					{
+						Kind:     rbacv1.UserKind,
+						APIGroup: rbacv1.GroupName,
+						Name:     "jane",
+					},
+					{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      "builder",
+						Namespace: "ci",
+					},
+					{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      "builder",
+						Namespace: "ci",
+					},
+				},
+			},
+			Want: `
+				kube_clusterrolebinding_created{clusterrolebinding="readers"} 1.5e+09
+				kube_clusterrolebinding_labels{clusterrolebinding="readers"} 1
+				kube_clusterrolebinding_info{role_kind="ClusterRole",role_name="reader",clusterrolebinding="readers"} 1
+				kube_clusterrolebinding_subject{clusterrolebinding="readers",subject_kind="ServiceAccount",subject_name="builder",subject_namespace="ci"} 1
+				kube_clusterrolebinding_subject{clusterrolebinding="readers",subject_kind="User",subject_name="jane",subject_namespace=""} 1
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(clusterRoleBindingMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+)
+
+var (
+	descComponentStatusLabelsDefaultLabels = []string{"componentstatus"}
+
+	componentStatusMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_componentstatus_status",
+			Type: metric.Gauge,
+			Help: "The condition of a cluster component.",
+			GenerateFunc: wrapComponentStatusFunc(func(c *v1.ComponentStatus) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, cond := range c.Conditions {
+					conditionMetrics := addConditionMetrics(cond.Status)
+					for _, m := range conditionMetrics {
+						m.LabelKeys = []string{"condition", "status"}
+						m.LabelValues = append([]string{string(cond.Type)}, m.LabelValues...)
+					}
+					ms = append(ms, conditionMetrics...)
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_componentstatus_error",
+			Type: metric.Gauge,
+			Help: "Error reported for a condition of a cluster component, identified by a hash of the error message.",
+			GenerateFunc: wrapComponentStatusFunc(func(c *v1.ComponentStatus) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, cond := range c.Conditions {
+					if cond.Error == "" {
+						continue
+					}
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"condition", "error_hash"},
+						LabelValues: []string{string(cond.Type), componentStatusErrorHash(cond.Error)},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+
+	componentStatusPollMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_componentstatus_poll_interval_seconds",
+			Type: metric.Gauge,
+			Help: "Interval at which component statuses are polled from the API server.",
+			GenerateFunc: wrapComponentStatusPollFunc(func(p *componentStatusPoll) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: p.interval.Seconds(),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_componentstatus_last_successful_poll_timestamp_seconds",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last successful poll of component statuses.",
+			GenerateFunc: wrapComponentStatusPollFunc(func(p *componentStatusPoll) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !p.lastSuccess.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(p.lastSuccess.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+)
+
+func wrapComponentStatusFunc(f func(*v1.ComponentStatus) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		componentStatus := obj.(*v1.ComponentStatus)
+
+		metricFamily := f(componentStatus)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descComponentStatusLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{componentStatus.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func wrapComponentStatusPollFunc(f func(*componentStatusPoll) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		return f(obj.(*componentStatusPoll))
+	}
+}
+
+// componentStatusErrorDigitsRE matches the numbers in an error message, e.g.
+// addresses, ports and timings.
+var componentStatusErrorDigitsRE = regexp.MustCompile(`[0-9]+`)
+
+// componentStatusErrorHash reduces an error message to a short hash. Numbers
+// are stripped from the message before hashing, as addresses and timings
+// would otherwise produce a new series whenever they change, while the
+// component keeps failing for the same reason.
+func componentStatusErrorHash(msg string) string {
+	h := fnv.New32a()
+	h.Write([]byte(componentStatusErrorDigitsRE.ReplaceAllString(msg, "0")))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// componentStatusPoll describes the polling of component statuses.
+type componentStatusPoll struct {
+	interval    time.Duration
+	lastSuccess time.Time
+}
+
+// componentStatusStore keeps the metrics of the component statuses returned by
+// the last successful poll. ComponentStatus has no watch semantics and its
+// objects carry no UID, hence neither a reflector nor a MetricsStore can be
+// used.
+type componentStatusStore struct {
+	// Protects metrics and poll
+	mutex sync.RWMutex
+	// metrics is a map indexed by component name, containing a slice of
+	// metric families, containing a slice of metrics.
+	metrics map[string][]metricsstore.FamilyStringer
+	poll    componentStatusPoll
+
+	headers                 []string
+	generateMetricsFunc     func(interface{}) []metricsstore.FamilyStringer
+	pollHeaders             []string
+	generatePollMetricsFunc func(interface{}) []metricsstore.FamilyStringer
+}
+
+func newComponentStatusStore(
+	headers []string,
+	generateFunc func(interface{}) []metricsstore.FamilyStringer,
+	pollHeaders []string,
+	generatePollFunc func(interface{}) []metricsstore.FamilyStringer,
+	interval time.Duration,
+) *componentStatusStore {
+	return &componentStatusStore{
+		metrics:                 map[string][]metricsstore.FamilyStringer{},
+		poll:                    componentStatusPoll{interval: interval},
+		headers:                 headers,
+		generateMetricsFunc:     generateFunc,
+		pollHeaders:             pollHeaders,
+		generatePollMetricsFunc: generatePollFunc,
+	}
+}
+
+// Run polls component statuses from the API server every poll interval until
+// the given channel is closed.
+func (s *componentStatusStore) Run(kubeClient clientset.Interface, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		list, err := kubeClient.CoreV1().ComponentStatuses().List(metav1.ListOptions{})
+		if err != nil {
+			klog.Errorf("Failed to poll component statuses: %v", err)
+			return
+		}
+		s.replace(list.Items, time.Now())
+	}, s.poll.interval, stopCh)
+}
+
+// replace replaces the contents of the store with the given component
+// statuses, recording the time they were polled at.
+func (s *componentStatusStore) replace(items []v1.ComponentStatus, polled time.Time) {
+	metrics := make(map[string][]metricsstore.FamilyStringer, len(items))
+	for i := range items {
+		metrics[items[i].Name] = s.generateMetricsFunc(&items[i])
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.metrics = metrics
+	s.poll.lastSuccess = polled
+}
+
+// WriteAll writes all metrics of the store into the given writer, zipped with
+// the help text of each metric family.
+func (s *componentStatusStore) WriteAll(w io.Writer) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for i, help := range s.headers {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		for _, metricFamilies := range s.metrics {
+			w.Write([]byte(metricFamilies[i].String()))
+		}
+	}
+
+	poll := s.poll
+	pollMetricFamilies := s.generatePollMetricsFunc(&poll)
+	for i, help := range s.pollHeaders {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		w.Write([]byte(pollMetricFamilies[i].String()))
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+	"k8s.io/kube-state-metrics/pkg/options"
+)
+
+func TestComponentStatusCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_componentstatus_status The condition of a cluster component.
+		# TYPE kube_componentstatus_status gauge
+		# HELP kube_componentstatus_error Error reported for a condition of a cluster component, identified by a hash of the error message.
+		# TYPE kube_componentstatus_error gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &v1.ComponentStatus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scheduler",
+				},
+				Conditions: []v1.ComponentCondition{
+					{
+						Type:    v1.ComponentHealthy,
+						Status:  v1.ConditionTrue,
+						Message: "ok",
+					},
+				},
+			},
+			Want: `
+				kube_componentstatus_status{componentstatus="scheduler",condition="Healthy",status="true"} 1
+				kube_componentstatus_status{componentstatus="scheduler",condition="Healthy",status="false"} 0
+				kube_componentstatus_status{componentstatus="scheduler",condition="Healthy",status="unknown"} 0
+			`,
+		},
+		{
+			Obj: &v1.ComponentStatus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "etcd-0",
+				},
+				Conditions: []v1.ComponentCondition{
+					{
+						Type:   v1.ComponentHealthy,
+						Status: v1.ConditionFalse,
+						Error:  "Get http://127.0.0.1:2379/health: dial tcp 127.0.0.1:2379: connect: connection refused",
+					},
+				},
+			},
+			Want: `
+				kube_componentstatus_status{componentstatus="etcd-0",condition="Healthy",status="true"} 0
+				kube_componentstatus_status{componentstatus="etcd-0",condition="Healthy",status="false"} 1
+				kube_componentstatus_status{componentstatus="etcd-0",condition="Healthy",status="unknown"} 0
+				kube_componentstatus_error{componentstatus="etcd-0",condition="Healthy",error_hash="` + componentStatusErrorHash("Get http://127.0.0.1:2379/health: dial tcp 127.0.0.1:2379: connect: connection refused") + `"} 1
+			`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(componentStatusMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
+
+func TestComponentStatusStore(t *testing.T) {
+	s := newComponentStatusStore(
+		metric.ExtractMetricFamilyHeaders(componentStatusMetricFamilies),
+		metric.ComposeMetricGenFuncs(componentStatusMetricFamilies),
+		metric.ExtractMetricFamilyHeaders(componentStatusPollMetricFamilies),
+		metric.ComposeMetricGenFuncs(componentStatusPollMetricFamilies),
+		30*time.Second,
+	)
+
+	scrape := func() string {
+		w := strings.Builder{}
+		s.WriteAll(&w)
+		return strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_componentstatus_"}), "\n")
+	}
+
+	// Before the first successful poll only the poll interval is known.
+	if out := scrape(); out != "kube_componentstatus_poll_interval_seconds 30" {
+		t.Errorf("unexpected metrics before first poll:\n%s", out)
+	}
+
+	s.replace([]v1.ComponentStatus{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "controller-manager"},
+			Conditions: []v1.ComponentCondition{
+				{Type: v1.ComponentHealthy, Status: v1.ConditionTrue},
+			},
+		},
+	}, time.Unix(1500000000, 0))
+
+	want := []string{
+		`kube_componentstatus_status{componentstatus="controller-manager",condition="Healthy",status="true"} 1`,
+		`kube_componentstatus_status{componentstatus="controller-manager",condition="Healthy",status="false"} 0`,
+		`kube_componentstatus_status{componentstatus="controller-manager",condition="Healthy",status="unknown"} 0`,
+		`kube_componentstatus_poll_interval_seconds 30`,
+		`kube_componentstatus_last_successful_poll_timestamp_seconds 1.5e+09`,
+	}
+	if out := scrape(); out != strings.Join(want, "\n") {
+		t.Errorf("unexpected metrics after poll:\n%s", out)
+	}
+}
+
+func TestBuilderComponentStatusPollInterval(t *testing.T) {
+	b := NewBuilder(context.TODO())
+	if b.componentStatusPollInterval != options.DefaultComponentStatusPollInterval {
+		t.Errorf("expected default poll interval %v, got %v", options.DefaultComponentStatusPollInterval, b.componentStatusPollInterval)
+	}
+}
+
+func TestComponentStatusErrorHash(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{
+			a:     "Get https://10.0.0.1:2379/health: net/http: request canceled after 5.001s",
+			b:     "Get https://10.0.0.2:2379/health: net/http: request canceled after 4.99s",
+			equal: true,
+		},
+		{
+			a:     "Get http://127.0.0.1:10251/healthz: dial tcp 127.0.0.1:10251: connect: connection refused",
+			b:     "Get http://127.0.0.1:10251/healthz: net/http: request canceled",
+			equal: false,
+		},
+	}
+
+	for i, test := range tests {
+		a, b := componentStatusErrorHash(test.a), componentStatusErrorHash(test.b)
+		if (a == b) != test.equal {
+			t.Errorf("unexpected hashes in %vth run: %q and %q, expected equal: %v", i, a, b, test.equal)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/watch"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -179,6 +180,84 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_daemonset_status_observed_generation",
+			Type: metric.Gauge,
+			Help: "The most recent generation observed by the daemon set controller.",
+			GenerateFunc: wrapDaemonSetFunc(func(d *v1beta1.DaemonSet) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(d.Status.ObservedGeneration),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_daemonset_spec_update_strategy",
+			Type: metric.Gauge,
+			Help: "The update strategy used to replace old daemon pods with new ones.",
+			GenerateFunc: wrapDaemonSetFunc(func(d *v1beta1.DaemonSet) *metric.Family {
+				if d.Spec.UpdateStrategy.Type == "" {
+					return &metric.Family{
+						Metrics: []*metric.Metric{},
+					}
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"type"},
+							LabelValues: []string{string(d.Spec.UpdateStrategy.Type)},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_daemonset_spec_strategy_rollingupdate_max_unavailable",
+			Type: metric.Gauge,
+			Help: "Maximum number of nodes with an unavailable daemon pod during a rolling update of a daemon set.",
+			GenerateFunc: wrapDaemonSetFunc(func(d *v1beta1.DaemonSet) *metric.Family {
+				ru := d.Spec.UpdateStrategy.RollingUpdate
+				if ru == nil || ru.MaxUnavailable == nil {
+					return &metric.Family{
+						Metrics: []*metric.Metric{},
+					}
+				}
+
+				maxUnavailable, err := intstr.GetValueFromIntOrPercent(ru.MaxUnavailable, int(d.Status.DesiredNumberScheduled), true)
+				if err != nil {
+					return &metric.Family{
+						Metrics: []*metric.Metric{},
+					}
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(maxUnavailable),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_daemonset_spec_min_ready_seconds",
+			Type: metric.Gauge,
+			Help: "Minimum number of seconds a newly created daemon pod should be ready without any of its containers crashing to be considered available.",
+			GenerateFunc: wrapDaemonSetFunc(func(d *v1beta1.DaemonSet) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(d.Spec.MinReadySeconds),
+						},
+					},
+				}
+			}),
+		},
 		{
 			Name: descDaemonSetLabelsName,
 			Type: metric.Gauge,
@@ -22,9 +22,14 @@ import (
 
 	"k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kube-state-metrics/pkg/metric"
 )
 
+var (
+	ds4MaxUnavailable = intstr.FromString("25%")
+)
+
 func TestDaemonSetCollector(t *testing.T) {
 	// Fixed metadata on type and help text. We prepend this to every expected
 	// output so we only have to modify a single place when doing adjustments.
@@ -49,6 +54,14 @@ func TestDaemonSetCollector(t *testing.T) {
 		# TYPE kube_daemonset_updated_number_scheduled gauge
 		# HELP kube_daemonset_labels Kubernetes labels converted to Prometheus labels.
 		# TYPE kube_daemonset_labels gauge
+		# HELP kube_daemonset_status_observed_generation The most recent generation observed by the daemon set controller.
+		# TYPE kube_daemonset_status_observed_generation gauge
+		# HELP kube_daemonset_spec_update_strategy The update strategy used to replace old daemon pods with new ones.
+		# TYPE kube_daemonset_spec_update_strategy gauge
+		# HELP kube_daemonset_spec_strategy_rollingupdate_max_unavailable Maximum number of nodes with an unavailable daemon pod during a rolling update of a daemon set.
+		# TYPE kube_daemonset_spec_strategy_rollingupdate_max_unavailable gauge
+		# HELP kube_daemonset_spec_min_ready_seconds Minimum number of seconds a newly created daemon pod should be ready without any of its containers crashing to be considered available.
+		# TYPE kube_daemonset_spec_min_ready_seconds gauge
 `
 	cases := []generateMetricsTestCase{
 		{
@@ -180,6 +193,60 @@ func TestDaemonSetCollector(t *testing.T) {
 				"kube_daemonset_updated_number_scheduled",
 			},
 		},
+		{
+			Obj: &v1beta1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "ds4",
+					Namespace:  "ns4",
+					Generation: 3,
+				},
+				Spec: v1beta1.DaemonSetSpec{
+					MinReadySeconds: 10,
+					UpdateStrategy: v1beta1.DaemonSetUpdateStrategy{
+						Type: v1beta1.RollingUpdateDaemonSetStrategyType,
+						RollingUpdate: &v1beta1.RollingUpdateDaemonSet{
+							MaxUnavailable: &ds4MaxUnavailable,
+						},
+					},
+				},
+				Status: v1beta1.DaemonSetStatus{
+					DesiredNumberScheduled: 10,
+					ObservedGeneration:     2,
+				},
+			},
+			Want: `
+				kube_daemonset_status_observed_generation{daemonset="ds4",namespace="ns4"} 2
+				kube_daemonset_spec_update_strategy{daemonset="ds4",namespace="ns4",type="RollingUpdate"} 1
+				kube_daemonset_spec_strategy_rollingupdate_max_unavailable{daemonset="ds4",namespace="ns4"} 3
+				kube_daemonset_spec_min_ready_seconds{daemonset="ds4",namespace="ns4"} 10
+`,
+			MetricNames: []string{
+				"kube_daemonset_status_observed_generation",
+				"kube_daemonset_spec_update_strategy",
+				"kube_daemonset_spec_strategy_rollingupdate_max_unavailable",
+				"kube_daemonset_spec_min_ready_seconds",
+			},
+		},
+		{
+			Obj: &v1beta1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ds5",
+					Namespace: "ns5",
+				},
+				Spec: v1beta1.DaemonSetSpec{
+					UpdateStrategy: v1beta1.DaemonSetUpdateStrategy{
+						Type: v1beta1.OnDeleteDaemonSetStrategyType,
+					},
+				},
+			},
+			Want: `
+				kube_daemonset_spec_update_strategy{daemonset="ds5",namespace="ns5",type="OnDelete"} 1
+`,
+			MetricNames: []string{
+				"kube_daemonset_spec_update_strategy",
+				"kube_daemonset_spec_strategy_rollingupdate_max_unavailable",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(daemonSetMetricFamilies)
@@ -17,6 +17,12 @@ limitations under the License.
 package collector
 
 import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/kube-state-metrics/pkg/metric"
 
@@ -120,9 +126,103 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_endpoint_address_info",
+			Type: metric.Gauge,
+			Help: "Information about each address of each subset in endpoint.",
+			GenerateFunc: wrapEndpointFunc(func(e *v1.Endpoints) *metric.Family {
+				ms := []*metric.Metric{}
+				seen := map[string]struct{}{}
+
+				addAddresses := func(subset string, addresses []v1.EndpointAddress, ready string) {
+					for _, a := range addresses {
+						nodeName := ""
+						if a.NodeName != nil {
+							nodeName = *a.NodeName
+						}
+						targetPod := ""
+						if a.TargetRef != nil && a.TargetRef.Kind == "Pod" {
+							targetPod = a.TargetRef.Name
+						}
+
+						// Subsets which are not packed by the endpoints
+						// controller can share the same key.
+						key := strings.Join([]string{subset, a.IP, a.Hostname, nodeName, targetPod, ready}, "/")
+						if _, ok := seen[key]; ok {
+							continue
+						}
+						seen[key] = struct{}{}
+
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"subset", "ip", "hostname", "node", "target_pod", "ready"},
+							LabelValues: []string{subset, a.IP, a.Hostname, nodeName, targetPod, ready},
+							Value:       1,
+						})
+					}
+				}
+
+				for _, s := range e.Subsets {
+					subset := endpointSubsetKey(s)
+					addAddresses(subset, s.Addresses, "true")
+					addAddresses(subset, s.NotReadyAddresses, "false")
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_endpoint_port",
+			Type: metric.Gauge,
+			Help: "Information about each port of each subset in endpoint.",
+			GenerateFunc: wrapEndpointFunc(func(e *v1.Endpoints) *metric.Family {
+				ms := []*metric.Metric{}
+				seen := map[string]struct{}{}
+
+				for _, s := range e.Subsets {
+					subset := endpointSubsetKey(s)
+					for _, p := range s.Ports {
+						port := strconv.FormatInt(int64(p.Port), 10)
+
+						key := strings.Join([]string{subset, p.Name, string(p.Protocol), port}, "/")
+						if _, ok := seen[key]; ok {
+							continue
+						}
+						seen[key] = struct{}{}
+
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"subset", "port_name", "port_protocol", "port_number"},
+							LabelValues: []string{subset, p.Name, string(p.Protocol), port},
+							Value:       1,
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
 	}
 )
 
+// endpointSubsetKey identifies a subset of an endpoint by a hash of its ports.
+// The endpoints controller packs the addresses sharing the same ports into one
+// subset, so unlike the position of a subset its ports stay the same when
+// addresses come and go.
+func endpointSubsetKey(s v1.EndpointSubset) string {
+	ports := make([]string, len(s.Ports))
+	for i, p := range s.Ports {
+		ports[i] = strings.Join([]string{p.Name, string(p.Protocol), strconv.FormatInt(int64(p.Port), 10)}, "/")
+	}
+	sort.Strings(ports)
+
+	h := fnv.New32a()
+	h.Write([]byte(strings.Join(ports, ",")))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
 func wrapEndpointFunc(f func(*v1.Endpoints) *metric.Family) func(interface{}) *metric.Family {
 	return func(obj interface{}) *metric.Family {
 		endpoint := obj.(*v1.Endpoints)
@@ -41,7 +41,12 @@ func TestEndpointCollector(t *testing.T) {
 		# TYPE kube_endpoint_info gauge
 		# HELP kube_endpoint_labels Kubernetes labels converted to Prometheus labels.
 		# TYPE kube_endpoint_labels gauge
+		# HELP kube_endpoint_address_info Information about each address of each subset in endpoint.
+		# TYPE kube_endpoint_address_info gauge
+		# HELP kube_endpoint_port Information about each port of each subset in endpoint.
+		# TYPE kube_endpoint_port gauge
 	`
+	nodeName := "node1"
 	cases := []generateMetricsTestCase{
 		{
 			Obj: &v1.Endpoints{
@@ -90,8 +95,85 @@ func TestEndpointCollector(t *testing.T) {
 				kube_endpoint_created{endpoint="test-endpoint",namespace="default"} 1.5e+09
 				kube_endpoint_info{endpoint="test-endpoint",namespace="default"} 1
 				kube_endpoint_labels{endpoint="test-endpoint",label_app="foobar",namespace="default"} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="127.0.0.1",namespace="default",node="",ready="true",subset="89fdf1e8",target_pod=""} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="10.0.0.1",namespace="default",node="",ready="true",subset="89fdf1e8",target_pod=""} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="172.22.23.202",namespace="default",node="",ready="true",subset="c45638cc",target_pod=""} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="192.168.1.1",namespace="default",node="",ready="false",subset="120b01fb",target_pod=""} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="192.168.1.3",namespace="default",node="",ready="false",subset="120b01fb",target_pod=""} 1
+				kube_endpoint_address_info{endpoint="test-endpoint",hostname="",ip="192.168.2.2",namespace="default",node="",ready="false",subset="120b01fb",target_pod=""} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="8080",port_protocol="",subset="89fdf1e8"} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="8081",port_protocol="",subset="89fdf1e8"} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="8443",port_protocol="",subset="c45638cc"} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="9090",port_protocol="",subset="c45638cc"} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="1234",port_protocol="",subset="120b01fb"} 1
+				kube_endpoint_port{endpoint="test-endpoint",namespace="default",port_name="",port_number="5678",port_protocol="",subset="120b01fb"} 1
 			`,
 		},
+		{
+			Obj: &v1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-endpoint2",
+					Namespace: "default",
+				},
+				Subsets: []v1.EndpointSubset{
+					{
+						Addresses: []v1.EndpointAddress{
+							{
+								IP:       "10.0.0.1",
+								Hostname: "web-0",
+								NodeName: &nodeName,
+								TargetRef: &v1.ObjectReference{
+									Kind:      "Pod",
+									Namespace: "default",
+									Name:      "web-0",
+								},
+							},
+						},
+						NotReadyAddresses: []v1.EndpointAddress{
+							{
+								IP:       "10.0.0.2",
+								NodeName: &nodeName,
+								TargetRef: &v1.ObjectReference{
+									Kind:      "Pod",
+									Namespace: "default",
+									Name:      "web-1",
+								},
+							},
+						},
+						Ports: []v1.EndpointPort{
+							{Name: "http", Port: 8080, Protocol: v1.ProtocolTCP},
+						},
+					},
+					{
+						Addresses: []v1.EndpointAddress{
+							{
+								IP:       "10.0.0.1",
+								Hostname: "web-0",
+								NodeName: &nodeName,
+								TargetRef: &v1.ObjectReference{
+									Kind:      "Pod",
+									Namespace: "default",
+									Name:      "web-0",
+								},
+							},
+						},
+						Ports: []v1.EndpointPort{
+							{Name: "http", Port: 8080, Protocol: v1.ProtocolTCP},
+							{Name: "dns", Port: 53, Protocol: v1.ProtocolUDP},
+						},
+					},
+				},
+			},
+			Want: `
+				kube_endpoint_address_info{endpoint="test-endpoint2",hostname="web-0",ip="10.0.0.1",namespace="default",node="node1",ready="true",subset="f74b99ce",target_pod="web-0"} 1
+				kube_endpoint_address_info{endpoint="test-endpoint2",hostname="",ip="10.0.0.2",namespace="default",node="node1",ready="false",subset="f74b99ce",target_pod="web-1"} 1
+				kube_endpoint_address_info{endpoint="test-endpoint2",hostname="web-0",ip="10.0.0.1",namespace="default",node="node1",ready="true",subset="e855ed20",target_pod="web-0"} 1
+				kube_endpoint_port{endpoint="test-endpoint2",namespace="default",port_name="http",port_number="8080",port_protocol="TCP",subset="f74b99ce"} 1
+				kube_endpoint_port{endpoint="test-endpoint2",namespace="default",port_name="http",port_number="8080",port_protocol="TCP",subset="e855ed20"} 1
+				kube_endpoint_port{endpoint="test-endpoint2",namespace="default",port_name="dns",port_number="53",port_protocol="UDP",subset="e855ed20"} 1
+			`,
+			MetricNames: []string{"kube_endpoint_address_info", "kube_endpoint_port"},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(endpointMetricFamilies)
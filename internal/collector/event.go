@@ -0,0 +1,293 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descEventLabelsDefaultLabels = []string{"namespace", "kind", "reason", "type"}
+
+	eventMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_event_aggregate_occurrences",
+			Type: metric.Gauge,
+			Help: "Number of occurrences of events retained by the API server, aggregated by namespace, involved object kind, reason and type.",
+			GenerateFunc: wrapEventAggregateFunc(func(a *eventAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: a.count,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_event_last_timestamp",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the most recent occurrence of the aggregated events.",
+			GenerateFunc: wrapEventAggregateFunc(func(a *eventAggregate) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !a.lastTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(a.lastTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+)
+
+// eventKey identifies the group of events aggregated into a single series.
+type eventKey struct {
+	namespace string
+	kind      string
+	reason    string
+	eventType string
+}
+
+// eventAggregate holds the occurrence count and most recent occurrence of
+// either a single event or all events sharing the same eventKey.
+type eventAggregate struct {
+	eventKey
+	count         float64
+	lastTimestamp time.Time
+}
+
+func wrapEventAggregateFunc(f func(*eventAggregate) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		aggregate := obj.(*eventAggregate)
+
+		metricFamily := f(aggregate)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descEventLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{aggregate.namespace, aggregate.kind, aggregate.reason, aggregate.eventType}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+// eventStore implements the k8s.io/client-go/tools/cache.Store interface.
+// Unlike MetricsStore, which keeps metrics per object, it keeps the count and
+// last occurrence of each event, and aggregates them by eventKey on every
+// scrape to keep the number of series bounded.
+type eventStore struct {
+	// Protects events
+	mutex sync.RWMutex
+	// events is a map indexed by Kubernetes object id, containing the
+	// aggregation key, count and last occurrence of each event.
+	events map[types.UID]eventAggregate
+	// reasons is the allow list of event reasons. Events with any other
+	// reason are dropped. An empty allow list admits all reasons.
+	reasons map[string]struct{}
+
+	headers             []string
+	generateMetricsFunc func(interface{}) []metricsstore.FamilyStringer
+}
+
+func newEventStore(headers []string, generateFunc func(interface{}) []metricsstore.FamilyStringer, reasons []string) *eventStore {
+	allowed := make(map[string]struct{}, len(reasons))
+	for _, r := range reasons {
+		allowed[r] = struct{}{}
+	}
+
+	return &eventStore{
+		events:              map[types.UID]eventAggregate{},
+		reasons:             allowed,
+		headers:             headers,
+		generateMetricsFunc: generateFunc,
+	}
+}
+
+// Add records the given event if its reason is allowed.
+func (s *eventStore) Add(obj interface{}) error {
+	e, ok := obj.(*v1.Event)
+	if !ok {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, allowed := s.reasons[e.Reason]; len(s.reasons) > 0 && !allowed {
+		delete(s.events, e.UID)
+		return nil
+	}
+
+	// Events recorded through the events.k8s.io API may leave count unset.
+	count := e.Count
+	if count < 1 {
+		count = 1
+	}
+
+	s.events[e.UID] = eventAggregate{
+		eventKey: eventKey{
+			namespace: e.Namespace,
+			kind:      e.InvolvedObject.Kind,
+			reason:    e.Reason,
+			eventType: e.Type,
+		},
+		count:         float64(count),
+		lastTimestamp: eventLastTimestamp(e),
+	}
+
+	return nil
+}
+
+// Update updates the existing entry in the eventStore.
+func (s *eventStore) Update(obj interface{}) error {
+	return s.Add(obj)
+}
+
+// Delete deletes an existing entry in the eventStore.
+func (s *eventStore) Delete(obj interface{}) error {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.events, o.GetUID())
+
+	return nil
+}
+
+// List implements the List method of the store interface.
+func (s *eventStore) List() []interface{} {
+	return nil
+}
+
+// ListKeys implements the ListKeys method of the store interface.
+func (s *eventStore) ListKeys() []string {
+	return nil
+}
+
+// Get implements the Get method of the store interface.
+func (s *eventStore) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	return nil, false, nil
+}
+
+// GetByKey implements the GetByKey method of the store interface.
+func (s *eventStore) GetByKey(key string) (item interface{}, exists bool, err error) {
+	return nil, false, nil
+}
+
+// Replace will delete the contents of the store, using instead the
+// given list.
+func (s *eventStore) Replace(list []interface{}, _ string) error {
+	s.mutex.Lock()
+	s.events = map[types.UID]eventAggregate{}
+	s.mutex.Unlock()
+
+	for _, o := range list {
+		err := s.Add(o)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Resync implements the Resync method of the store interface.
+func (s *eventStore) Resync() error {
+	return nil
+}
+
+// WriteAll aggregates all events of the store by eventKey and writes the
+// resulting metrics into the given writer, zipped with the help text of each
+// metric family.
+func (s *eventStore) WriteAll(w io.Writer) {
+	s.mutex.RLock()
+	aggregates := map[eventKey]*eventAggregate{}
+	for _, e := range s.events {
+		a, ok := aggregates[e.eventKey]
+		if !ok {
+			a = &eventAggregate{eventKey: e.eventKey}
+			aggregates[e.eventKey] = a
+		}
+		a.count += e.count
+		if e.lastTimestamp.After(a.lastTimestamp) {
+			a.lastTimestamp = e.lastTimestamp
+		}
+	}
+	s.mutex.RUnlock()
+
+	families := make([][]metricsstore.FamilyStringer, 0, len(aggregates))
+	for _, a := range aggregates {
+		families = append(families, s.generateMetricsFunc(a))
+	}
+
+	for i, help := range s.headers {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		for _, f := range families {
+			w.Write([]byte(f[i].String()))
+		}
+	}
+}
+
+// eventLastTimestamp returns the time of the most recent occurrence of the
+// event, falling back to the fields populated by the events.k8s.io API.
+func eventLastTimestamp(e *v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.FirstTimestamp.Time
+	}
+}
+
+func createEventListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.CoreV1().Events(ns).List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.CoreV1().Events(ns).Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func newTestEvent(uid, namespace, kind, reason, eventType string, count int32, last int64) *v1.Event {
+	return &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      uid,
+			Namespace: namespace,
+			UID:       types.UID(uid),
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      kind,
+			Namespace: namespace,
+		},
+		Reason:        reason,
+		Type:          eventType,
+		Count:         count,
+		LastTimestamp: metav1.Time{Time: time.Unix(last, 0)},
+	}
+}
+
+func TestEventStore(t *testing.T) {
+	cases := []struct {
+		reasons []string
+		add     []*v1.Event
+		delete  []*v1.Event
+		want    string
+	}{
+		// Events sharing namespace, kind, reason and type are aggregated.
+		{
+			reasons: []string{"BackOff"},
+			add: []*v1.Event{
+				newTestEvent("e1", "ns1", "Pod", "BackOff", "Warning", 3, 1500000000),
+				newTestEvent("e2", "ns1", "Pod", "BackOff", "Warning", 2, 1500000100),
+				newTestEvent("e3", "ns2", "Pod", "BackOff", "Warning", 0, 1500000050),
+			},
+			want: `
+				kube_event_aggregate_occurrences{kind="Pod",namespace="ns1",reason="BackOff",type="Warning"} 5
+				kube_event_aggregate_occurrences{kind="Pod",namespace="ns2",reason="BackOff",type="Warning"} 1
+				kube_event_last_timestamp{kind="Pod",namespace="ns1",reason="BackOff",type="Warning"} 1.5000001e+09
+				kube_event_last_timestamp{kind="Pod",namespace="ns2",reason="BackOff",type="Warning"} 1.50000005e+09
+			`,
+		},
+		// Events with reasons outside the allow list are dropped.
+		{
+			reasons: []string{"FailedMount"},
+			add: []*v1.Event{
+				newTestEvent("e1", "ns1", "Pod", "FailedMount", "Warning", 1, 1500000000),
+				newTestEvent("e2", "ns1", "Pod", "Scheduled", "Normal", 1, 1500000000),
+			},
+			want: `
+				kube_event_aggregate_occurrences{kind="Pod",namespace="ns1",reason="FailedMount",type="Warning"} 1
+				kube_event_last_timestamp{kind="Pod",namespace="ns1",reason="FailedMount",type="Warning"} 1.5e+09
+			`,
+		},
+		// An empty allow list admits events of any reason, and deleted
+		// events no longer contribute to the aggregate.
+		{
+			add: []*v1.Event{
+				newTestEvent("e1", "ns1", "Node", "Rebooted", "Warning", 1, 1500000000),
+				newTestEvent("e2", "ns1", "Node", "Rebooted", "Warning", 4, 1500000200),
+			},
+			delete: []*v1.Event{
+				newTestEvent("e2", "ns1", "Node", "Rebooted", "Warning", 4, 1500000200),
+			},
+			want: `
+				kube_event_aggregate_occurrences{kind="Node",namespace="ns1",reason="Rebooted",type="Warning"} 1
+				kube_event_last_timestamp{kind="Node",namespace="ns1",reason="Rebooted",type="Warning"} 1.5e+09
+			`,
+		},
+	}
+
+	headers := metric.ExtractMetricFamilyHeaders(eventMetricFamilies)
+	generateFunc := metric.ComposeMetricGenFuncs(eventMetricFamilies)
+
+	for i, c := range cases {
+		s := newEventStore(headers, generateFunc, c.reasons)
+		for _, e := range c.add {
+			if err := s.Add(e); err != nil {
+				t.Fatalf("unexpected error adding event in test case %v: %v", i, err)
+			}
+		}
+		for _, e := range c.delete {
+			if err := s.Delete(e); err != nil {
+				t.Fatalf("unexpected error deleting event in test case %v: %v", i, err)
+			}
+		}
+
+		w := strings.Builder{}
+		s.WriteAll(&w)
+
+		out := strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_event_"}), "\n")
+
+		if err := compareOutput(c.want, out); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -97,6 +97,68 @@ var (
 					}}
 			}),
 		},
+		{
+			Name: "kube_ingress_path",
+			Type: metric.Gauge,
+			Help: "Ingress host, paths and backend service information.",
+			GenerateFunc: wrapIngressFunc(func(i *v1beta1.Ingress) *metric.Family {
+				ms := []*metric.Metric{}
+
+				// The default backend serves requests matching no rule, hence
+				// it has neither host nor path.
+				if b := i.Spec.Backend; b != nil {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"host", "path", "service_name", "service_port"},
+						LabelValues: []string{"", "", b.ServiceName, b.ServicePort.String()},
+						Value:       1,
+					})
+				}
+
+				for _, rule := range i.Spec.Rules {
+					if rule.HTTP == nil {
+						continue
+					}
+					for _, path := range rule.HTTP.Paths {
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"host", "path", "service_name", "service_port"},
+							LabelValues: []string{rule.Host, path.Path, path.Backend.ServiceName, path.Backend.ServicePort.String()},
+							Value:       1,
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_ingress_tls",
+			Type: metric.Gauge,
+			Help: "Ingress TLS host and secret information.",
+			GenerateFunc: wrapIngressFunc(func(i *v1beta1.Ingress) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, tls := range i.Spec.TLS {
+					hosts := tls.Hosts
+					if len(hosts) == 0 {
+						// A TLS entry without hosts applies to the wildcard host.
+						hosts = []string{""}
+					}
+					for _, host := range hosts {
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"tls_host", "secret"},
+							LabelValues: []string{host, tls.SecretName},
+							Value:       1,
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
 	}
 )
 
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kube-state-metrics/pkg/metric"
 )
 
@@ -40,6 +41,10 @@ func TestIngressCollector(t *testing.T) {
 		# TYPE kube_ingress_created gauge
 		# HELP kube_ingress_metadata_resource_version Resource version representing a specific version of ingress.
 		# TYPE kube_ingress_metadata_resource_version gauge
+		# HELP kube_ingress_path Ingress host, paths and backend service information.
+		# TYPE kube_ingress_path gauge
+		# HELP kube_ingress_tls Ingress TLS host and secret information.
+		# TYPE kube_ingress_tls gauge
 	`
 	cases := []generateMetricsTestCase{
 		{
@@ -92,6 +97,81 @@ func TestIngressCollector(t *testing.T) {
 `,
 			MetricNames: []string{"kube_ingress_info", "kube_ingress_metadata_resource_version", "kube_ingress_created", "kube_ingress_labels"},
 		},
+		{
+			Obj: &v1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "ingress4",
+					Namespace:         "ns4",
+					CreationTimestamp: metav1StartTime,
+					ResourceVersion:   "abcdef",
+				},
+				Spec: v1beta1.IngressSpec{
+					Rules: []v1beta1.IngressRule{
+						{
+							Host: "somehost",
+							IngressRuleValue: v1beta1.IngressRuleValue{
+								HTTP: &v1beta1.HTTPIngressRuleValue{
+									Paths: []v1beta1.HTTPIngressPath{
+										{
+											Path: "/somepath",
+											Backend: v1beta1.IngressBackend{
+												ServiceName: "someservice",
+												ServicePort: intstr.FromInt(1234),
+											},
+										},
+										{
+											Path: "/otherpath",
+											Backend: v1beta1.IngressBackend{
+												ServiceName: "otherservice",
+												ServicePort: intstr.FromString("http"),
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Host: "somehost2",
+						},
+					},
+					TLS: []v1beta1.IngressTLS{
+						{
+							Hosts:      []string{"somehost1", "somehost2"},
+							SecretName: "somesecret",
+						},
+						{
+							SecretName: "defaultsecret",
+						},
+					},
+				},
+			},
+			Want: `
+				kube_ingress_path{host="somehost",ingress="ingress4",namespace="ns4",path="/somepath",service_name="someservice",service_port="1234"} 1
+				kube_ingress_path{host="somehost",ingress="ingress4",namespace="ns4",path="/otherpath",service_name="otherservice",service_port="http"} 1
+				kube_ingress_tls{ingress="ingress4",namespace="ns4",secret="somesecret",tls_host="somehost1"} 1
+				kube_ingress_tls{ingress="ingress4",namespace="ns4",secret="somesecret",tls_host="somehost2"} 1
+				kube_ingress_tls{ingress="ingress4",namespace="ns4",secret="defaultsecret",tls_host=""} 1
+`,
+			MetricNames: []string{"kube_ingress_path", "kube_ingress_tls"},
+		},
+		{
+			Obj: &v1beta1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress5",
+					Namespace: "ns5",
+				},
+				Spec: v1beta1.IngressSpec{
+					Backend: &v1beta1.IngressBackend{
+						ServiceName: "defaultservice",
+						ServicePort: intstr.FromInt(8080),
+					},
+				},
+			},
+			Want: `
+				kube_ingress_path{host="",ingress="ingress5",namespace="ns5",path="",service_name="defaultservice",service_port="8080"} 1
+`,
+			MetricNames: []string{"kube_ingress_path"},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(ingressMetricFamilies)
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descMutatingWebhookConfigurationDefaultLabels = []string{"mutatingwebhookconfiguration"}
+
+	mutatingWebhookConfigurationMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_mutatingwebhookconfiguration_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapMutatingWebhookConfigurationFunc(func(c *admissionregistration.MutatingWebhookConfiguration) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !c.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(c.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_mutatingwebhookconfiguration_webhook_info",
+			Type: metric.Gauge,
+			Help: "Information about a webhook of the mutatingwebhookconfiguration.",
+			GenerateFunc: wrapMutatingWebhookConfigurationFunc(func(c *admissionregistration.MutatingWebhookConfiguration) *metric.Family {
+				return &metric.Family{
+					Metrics: webhookInfoMetrics(c.Webhooks),
+				}
+			}),
+		},
+		{
+			Name: "kube_mutatingwebhookconfiguration_webhook_namespace_selector",
+			Type: metric.Gauge,
+			Help: "Whether a webhook of the mutatingwebhookconfiguration restricts the namespaces it applies to.",
+			GenerateFunc: wrapMutatingWebhookConfigurationFunc(func(c *admissionregistration.MutatingWebhookConfiguration) *metric.Family {
+				return &metric.Family{
+					Metrics: webhookNamespaceSelectorMetrics(c.Webhooks),
+				}
+			}),
+		},
+	}
+)
+
+func wrapMutatingWebhookConfigurationFunc(f func(*admissionregistration.MutatingWebhookConfiguration) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		mutatingWebhookConfiguration := obj.(*admissionregistration.MutatingWebhookConfiguration)
+
+		metricFamily := f(mutatingWebhookConfiguration)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descMutatingWebhookConfigurationDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{mutatingWebhookConfiguration.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createMutatingWebhookConfigurationListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestMutatingWebhookConfigurationCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_mutatingwebhookconfiguration_created Unix creation timestamp
+		# TYPE kube_mutatingwebhookconfiguration_created gauge
+		# HELP kube_mutatingwebhookconfiguration_webhook_info Information about a webhook of the mutatingwebhookconfiguration.
+		# TYPE kube_mutatingwebhookconfiguration_webhook_info gauge
+		# HELP kube_mutatingwebhookconfiguration_webhook_namespace_selector Whether a webhook of the mutatingwebhookconfiguration restricts the namespaces it applies to.
+		# TYPE kube_mutatingwebhookconfiguration_webhook_namespace_selector gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &admissionregistration.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "sidecar-injector",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Webhooks: []admissionregistration.Webhook{
+					{
+						Name:          "sidecar-injector.example.com",
+						FailurePolicy: &webhookFailurePolicyFail,
+						ClientConfig: admissionregistration.WebhookClientConfig{
+							Service: &admissionregistration.ServiceReference{
+								Namespace: "injector-system",
+								Name:      "sidecar-injector",
+							},
+						},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{
+									Key:      "injection",
+									Operator: metav1.LabelSelectorOpNotIn,
+									Values:   []string{"disabled"},
+								},
+							},
+						},
+					},
+				},
+			},
+			Want: `
+				kube_mutatingwebhookconfiguration_created{mutatingwebhookconfiguration="sidecar-injector"} 1.5e+09
+				kube_mutatingwebhookconfiguration_webhook_info{failure_policy="Fail",mutatingwebhookconfiguration="sidecar-injector",service_name="sidecar-injector",service_namespace="injector-system",service_path="",url="",webhook="sidecar-injector.example.com"} 1
+				kube_mutatingwebhookconfiguration_webhook_namespace_selector{mutatingwebhookconfiguration="sidecar-injector",webhook="sidecar-injector.example.com"} 1
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(mutatingWebhookConfigurationMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+var (
+	descNamespaceAggregateLabelsDefaultLabels = []string{"namespace"}
+
+	namespaceAggregateMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_namespace_pods_phase",
+			Type: metric.Gauge,
+			Help: "Number of pods in the namespace per phase.",
+			GenerateFunc: wrapNamespaceAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: podAggregatePhaseMetrics(a),
+				}
+			}),
+		},
+		{
+			Name: "kube_namespace_pod_container_resource_requests",
+			Type: metric.Gauge,
+			Help: "Sum of the resources requested by the containers of all pods in the namespace.",
+			GenerateFunc: wrapNamespaceAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: resourceMetrics(a.requests),
+				}
+			}),
+		},
+		{
+			Name: "kube_namespace_pod_container_resource_limits",
+			Type: metric.Gauge,
+			Help: "Sum of the resource limits of the containers of all pods in the namespace.",
+			GenerateFunc: wrapNamespaceAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: resourceMetrics(a.limits),
+				}
+			}),
+		},
+		{
+			Name: "kube_namespace_pod_container_status_restarts",
+			Type: metric.Gauge,
+			Help: "Sum of the container restarts of all pods in the namespace.",
+			GenerateFunc: wrapNamespaceAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(a.restarts),
+						},
+					},
+				}
+			}),
+		},
+	}
+)
+
+func wrapNamespaceAggregateFunc(f func(*podAggregate) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		aggregate := obj.(*podAggregate)
+
+		metricFamily := f(aggregate)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descNamespaceAggregateLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{aggregate.key}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+// namespaceAggregateOf returns the contribution of the given pod to the
+// aggregate of its namespace. Containers are summed up the same way as in the
+// pod container resource families.
+func namespaceAggregateOf(p *v1.Pod) *podAggregate {
+	a := newPodAggregate(p.Namespace)
+	a.pods = 1
+
+	if p.Status.Phase != "" {
+		a.phases[p.Status.Phase] = 1
+	}
+
+	for _, c := range p.Spec.Containers {
+		for name, val := range c.Resources.Requests {
+			if k, v, ok := containerResourceValue(name, val); ok {
+				a.requests[k] += v
+			}
+		}
+		for name, val := range c.Resources.Limits {
+			if k, v, ok := containerResourceValue(name, val); ok {
+				a.limits[k] += v
+			}
+		}
+	}
+
+	for _, cs := range p.Status.ContainerStatuses {
+		a.restarts += int64(cs.RestartCount)
+	}
+
+	return a
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+)
+
+func newAggregateTestPod(uid, namespace string, phase v1.PodPhase, cpu string, restarts int32) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      uid,
+			Namespace: namespace,
+			UID:       types.UID(uid),
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "container1",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse(cpu),
+							v1.ResourceMemory: resource.MustParse("100M"),
+						},
+						Limits: v1.ResourceList{
+							v1.ResourceMemory: resource.MustParse("200M"),
+						},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{
+			Phase: phase,
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name:         "container1",
+					RestartCount: restarts,
+				},
+			},
+		},
+	}
+}
+
+func TestNamespaceAggregateStore(t *testing.T) {
+	s := newPodAggregateStore(
+		newPodControllerStore(
+			metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+			newOwnerIndex(),
+			nil,
+			metric.ComposeMetricGenFuncs(nil),
+		),
+		namespaceAggregateOf,
+		metric.ExtractMetricFamilyHeaders(namespaceAggregateMetricFamilies),
+		metric.ComposeMetricGenFuncs(namespaceAggregateMetricFamilies),
+	)
+
+	scrape := func() string {
+		w := strings.Builder{}
+		s.WriteAll(&w)
+		return strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_namespace_"}), "\n")
+	}
+
+	cases := []struct {
+		update func() error
+		want   string
+	}{
+		{
+			update: func() error {
+				for _, p := range []*v1.Pod{
+					newAggregateTestPod("pod1", "ns1", v1.PodRunning, "100m", 1),
+					newAggregateTestPod("pod2", "ns1", v1.PodPending, "250m", 0),
+					newAggregateTestPod("pod3", "ns2", v1.PodRunning, "1", 3),
+				} {
+					if err := s.Add(p); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			want: `
+				kube_namespace_pods_phase{namespace="ns1",phase="Pending"} 1
+				kube_namespace_pods_phase{namespace="ns1",phase="Running"} 1
+				kube_namespace_pods_phase{namespace="ns1",phase="Succeeded"} 0
+				kube_namespace_pods_phase{namespace="ns1",phase="Failed"} 0
+				kube_namespace_pods_phase{namespace="ns1",phase="Unknown"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Pending"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Running"} 1
+				kube_namespace_pods_phase{namespace="ns2",phase="Succeeded"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Failed"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Unknown"} 0
+				kube_namespace_pod_container_resource_requests{namespace="ns1",resource="cpu",unit="core"} 0.35
+				kube_namespace_pod_container_resource_requests{namespace="ns1",resource="memory",unit="byte"} 2e+08
+				kube_namespace_pod_container_resource_requests{namespace="ns2",resource="cpu",unit="core"} 1
+				kube_namespace_pod_container_resource_requests{namespace="ns2",resource="memory",unit="byte"} 1e+08
+				kube_namespace_pod_container_resource_limits{namespace="ns1",resource="memory",unit="byte"} 4e+08
+				kube_namespace_pod_container_resource_limits{namespace="ns2",resource="memory",unit="byte"} 2e+08
+				kube_namespace_pod_container_status_restarts{namespace="ns1"} 1
+				kube_namespace_pod_container_status_restarts{namespace="ns2"} 3
+			`,
+		},
+		// Updates replace the previous contribution of the pod, and
+		// namespaces without pods are dropped.
+		{
+			update: func() error {
+				if err := s.Update(newAggregateTestPod("pod2", "ns1", v1.PodRunning, "250m", 2)); err != nil {
+					return err
+				}
+				return s.Delete(newAggregateTestPod("pod3", "ns2", v1.PodRunning, "1", 3))
+			},
+			want: `
+				kube_namespace_pods_phase{namespace="ns1",phase="Pending"} 0
+				kube_namespace_pods_phase{namespace="ns1",phase="Running"} 2
+				kube_namespace_pods_phase{namespace="ns1",phase="Succeeded"} 0
+				kube_namespace_pods_phase{namespace="ns1",phase="Failed"} 0
+				kube_namespace_pods_phase{namespace="ns1",phase="Unknown"} 0
+				kube_namespace_pod_container_resource_requests{namespace="ns1",resource="cpu",unit="core"} 0.35
+				kube_namespace_pod_container_resource_requests{namespace="ns1",resource="memory",unit="byte"} 2e+08
+				kube_namespace_pod_container_resource_limits{namespace="ns1",resource="memory",unit="byte"} 4e+08
+				kube_namespace_pod_container_status_restarts{namespace="ns1"} 3
+			`,
+		},
+		{
+			update: func() error {
+				return s.Replace([]interface{}{
+					newAggregateTestPod("pod4", "ns2", v1.PodSucceeded, "500m", 0),
+				}, "")
+			},
+			want: `
+				kube_namespace_pods_phase{namespace="ns2",phase="Pending"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Running"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Succeeded"} 1
+				kube_namespace_pods_phase{namespace="ns2",phase="Failed"} 0
+				kube_namespace_pods_phase{namespace="ns2",phase="Unknown"} 0
+				kube_namespace_pod_container_resource_requests{namespace="ns2",resource="cpu",unit="core"} 0.5
+				kube_namespace_pod_container_resource_requests{namespace="ns2",resource="memory",unit="byte"} 1e+08
+				kube_namespace_pod_container_resource_limits{namespace="ns2",resource="memory",unit="byte"} 2e+08
+				kube_namespace_pod_container_status_restarts{namespace="ns2"} 0
+			`,
+		},
+	}
+
+	for i, c := range cases {
+		if err := c.update(); err != nil {
+			t.Fatalf("unexpected error updating store in %vth run: %v", i, err)
+		}
+		if err := compareOutput(c.want, scrape()); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descNetworkPolicyLabelsName          = "kube_networkpolicy_labels"
+	descNetworkPolicyLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descNetworkPolicyLabelsDefaultLabels = []string{"namespace", "networkpolicy"}
+
+	networkPolicyMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_networkpolicy_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !n.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(n.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descNetworkPolicyLabelsName,
+			Type: metric.Gauge,
+			Help: descNetworkPolicyLabelsHelp,
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(n.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_networkpolicy_spec_policy_types",
+			Type: metric.Gauge,
+			Help: "Rule types the network policy relates to.",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				ms := make([]*metric.Metric, len(n.Spec.PolicyTypes))
+
+				for i, t := range n.Spec.PolicyTypes {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"policy_type"},
+						LabelValues: []string{string(t)},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_networkpolicy_spec_ingress_rules",
+			Type: metric.Gauge,
+			Help: "Number of ingress rules of the network policy.",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(n.Spec.Ingress)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_networkpolicy_spec_egress_rules",
+			Type: metric.Gauge,
+			Help: "Number of egress rules of the network policy.",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(n.Spec.Egress)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_networkpolicy_spec_pod_selector",
+			Type: metric.Gauge,
+			Help: "Match labels of the network policy pod selector converted to Prometheus labels. An empty selector selects all pods in the namespace.",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				selectorKeys, selectorValues := mapToPrometheusLabels(n.Spec.PodSelector.MatchLabels, "selector")
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   selectorKeys,
+							LabelValues: selectorValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_networkpolicy_spec_pod_selector_match_expression",
+			Type: metric.Gauge,
+			Help: "Match expressions of the network policy pod selector.",
+			GenerateFunc: wrapNetworkPolicyFunc(func(n *networkingv1.NetworkPolicy) *metric.Family {
+				ms := make([]*metric.Metric, len(n.Spec.PodSelector.MatchExpressions))
+
+				for i, e := range n.Spec.PodSelector.MatchExpressions {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"key", "operator", "values"},
+						LabelValues: []string{e.Key, string(e.Operator), strings.Join(e.Values, ",")},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+)
+
+func wrapNetworkPolicyFunc(f func(*networkingv1.NetworkPolicy) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		networkPolicy := obj.(*networkingv1.NetworkPolicy)
+
+		metricFamily := f(networkPolicy)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descNetworkPolicyLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{networkPolicy.Namespace, networkPolicy.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createNetworkPolicyListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.NetworkingV1().NetworkPolicies(ns).List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.NetworkingV1().NetworkPolicies(ns).Watch(opts)
+		},
+	}
+}
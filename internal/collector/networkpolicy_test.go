@@ -0,0 +1,119 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestNetworkPolicyCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_networkpolicy_created Unix creation timestamp
+		# TYPE kube_networkpolicy_created gauge
+		# HELP kube_networkpolicy_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_networkpolicy_labels gauge
+		# HELP kube_networkpolicy_spec_policy_types Rule types the network policy relates to.
+		# TYPE kube_networkpolicy_spec_policy_types gauge
+		# HELP kube_networkpolicy_spec_ingress_rules Number of ingress rules of the network policy.
+		# TYPE kube_networkpolicy_spec_ingress_rules gauge
+		# HELP kube_networkpolicy_spec_egress_rules Number of egress rules of the network policy.
+		# TYPE kube_networkpolicy_spec_egress_rules gauge
+		# HELP kube_networkpolicy_spec_pod_selector Match labels of the network policy pod selector converted to Prometheus labels. An empty selector selects all pods in the namespace.
+		# TYPE kube_networkpolicy_spec_pod_selector gauge
+		# HELP kube_networkpolicy_spec_pod_selector_match_expression Match expressions of the network policy pod selector.
+		# TYPE kube_networkpolicy_spec_pod_selector_match_expression gauge
+	`
+	cases := []generateMetricsTestCase{
+		// Verify a default deny policy selecting all pods.
+		{
+			Obj: &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "default-deny",
+					Namespace:         "ns1",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PolicyTypes: []networkingv1.PolicyType{
+						networkingv1.PolicyTypeIngress,
+						networkingv1.PolicyTypeEgress,
+					},
+				},
+			},
+			Want: `
+				kube_networkpolicy_created{namespace="ns1",networkpolicy="default-deny"} 1.5e+09
+				kube_networkpolicy_labels{namespace="ns1",networkpolicy="default-deny"} 1
+				kube_networkpolicy_spec_policy_types{namespace="ns1",networkpolicy="default-deny",policy_type="Egress"} 1
+				kube_networkpolicy_spec_policy_types{namespace="ns1",networkpolicy="default-deny",policy_type="Ingress"} 1
+				kube_networkpolicy_spec_ingress_rules{namespace="ns1",networkpolicy="default-deny"} 0
+				kube_networkpolicy_spec_egress_rules{namespace="ns1",networkpolicy="default-deny"} 0
+				kube_networkpolicy_spec_pod_selector{namespace="ns1",networkpolicy="default-deny"} 1
+`,
+		},
+		{
+			Obj: &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "allow-frontend",
+					Namespace: "ns2",
+					Labels: map[string]string{
+						"team": "web",
+					},
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "backend",
+						},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{
+								Key:      "tier",
+								Operator: metav1.LabelSelectorOpIn,
+								Values:   []string{"api", "worker"},
+							},
+						},
+					},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{
+						{},
+						{},
+					},
+					Egress: []networkingv1.NetworkPolicyEgressRule{
+						{},
+					},
+				},
+			},
+			Want: `
+				kube_networkpolicy_labels{label_team="web",namespace="ns2",networkpolicy="allow-frontend"} 1
+				kube_networkpolicy_spec_ingress_rules{namespace="ns2",networkpolicy="allow-frontend"} 2
+				kube_networkpolicy_spec_egress_rules{namespace="ns2",networkpolicy="allow-frontend"} 1
+				kube_networkpolicy_spec_pod_selector{namespace="ns2",networkpolicy="allow-frontend",selector_app="backend"} 1
+				kube_networkpolicy_spec_pod_selector_match_expression{key="tier",namespace="ns2",networkpolicy="allow-frontend",operator="In",values="api,worker"} 1
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(networkPolicyMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package collector
 
 import (
+	"sort"
+	"strings"
+
 	"k8s.io/kube-state-metrics/pkg/constant"
 	"k8s.io/kube-state-metrics/pkg/metric"
 
@@ -33,6 +36,9 @@ var (
 	descNodeLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
 	descNodeLabelsDefaultLabels = []string{"node"}
 
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	nodeLegacyRoleLabel = "kubernetes.io/role"
+
 	nodeMetricFamilies = []metric.FamilyGenerator{
 		{
 			Name: "kube_node_info",
@@ -163,6 +169,50 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_node_status_condition_last_transition_time",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last transition of a cluster node condition.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, c := range n.Status.Conditions {
+					if !c.LastTransitionTime.IsZero() {
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"condition", "reason"},
+							LabelValues: []string{string(c.Type), c.Reason},
+							Value:       float64(c.LastTransitionTime.Unix()),
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_condition_last_heartbeat_time",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last heartbeat received for a cluster node condition.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, c := range n.Status.Conditions {
+					if !c.LastHeartbeatTime.IsZero() {
+						ms = append(ms, &metric.Metric{
+							LabelKeys:   []string{"condition", "reason"},
+							LabelValues: []string{string(c.Type), c.Reason},
+							Value:       float64(c.LastHeartbeatTime.Unix()),
+						})
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
 		{
 			Name: "kube_node_status_phase",
 			Type: metric.Gauge,
@@ -465,6 +515,146 @@ var (
 					})
 				}
 
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_node_role",
+			Type: metric.Gauge,
+			Help: "The role of a cluster node.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				roles := nodeRoles(n.Labels)
+				ms := make([]*metric.Metric, len(roles))
+
+				for i, role := range roles {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"role"},
+						LabelValues: []string{role},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_address",
+			Type: metric.Gauge,
+			Help: "The addresses of a cluster node. One series for each address.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				ms := make([]*metric.Metric, len(n.Status.Addresses))
+
+				for i, a := range n.Status.Addresses {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"type", "address"},
+						LabelValues: []string{string(a.Type), a.Address},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_volumes_attached",
+			Type: metric.Gauge,
+			Help: "The number of volumes attached to a cluster node.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(n.Status.VolumesAttached)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_volume_attached_info",
+			Type: metric.Gauge,
+			Help: "The volumes attached to a cluster node. One series for each volume.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				ms := make([]*metric.Metric, len(n.Status.VolumesAttached))
+
+				for i, v := range n.Status.VolumesAttached {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"volume", "device_path"},
+						LabelValues: []string{string(v.Name), v.DevicePath},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_volumes_in_use",
+			Type: metric.Gauge,
+			Help: "The number of volumes in use by a cluster node.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(n.Status.VolumesInUse)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_images",
+			Type: metric.Gauge,
+			Help: "The number of container images on a cluster node.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(len(n.Status.Images)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_images_size_bytes",
+			Type: metric.Gauge,
+			Help: "The total size of the container images on a cluster node in bytes.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				var size int64
+				for _, image := range n.Status.Images {
+					size += image.SizeBytes
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(size),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_node_status_kubelet_endpoint_port",
+			Type: metric.Gauge,
+			Help: "The port the kubelet of a cluster node is listening on.",
+			GenerateFunc: wrapNodeFunc(func(n *v1.Node) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if port := n.Status.DaemonEndpoints.KubeletEndpoint.Port; port != 0 {
+					ms = append(ms, &metric.Metric{
+						Value: float64(port),
+					})
+				}
+
 				return &metric.Family{
 					Metrics: ms,
 				}
@@ -473,6 +663,32 @@ var (
 	}
 )
 
+// nodeRoles returns the sorted roles of a node, derived from the
+// node-role.kubernetes.io/<role> labels as well as the legacy
+// kubernetes.io/role label.
+func nodeRoles(labels map[string]string) []string {
+	set := map[string]struct{}{}
+
+	for k, v := range labels {
+		switch {
+		case strings.HasPrefix(k, nodeRoleLabelPrefix):
+			if role := strings.TrimPrefix(k, nodeRoleLabelPrefix); role != "" {
+				set[role] = struct{}{}
+			}
+		case k == nodeLegacyRoleLabel && v != "":
+			set[v] = struct{}{}
+		}
+	}
+
+	roles := make([]string, 0, len(set))
+	for role := range set {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
 func wrapNodeFunc(f func(*v1.Node) *metric.Family) func(interface{}) *metric.Family {
 	return func(obj interface{}) *metric.Family {
 		node := obj.(*v1.Node)
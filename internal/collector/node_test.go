@@ -60,6 +60,26 @@ func TestNodeCollector(t *testing.T) {
 		# HELP kube_node_status_allocatable_memory_bytes The memory resources of a node that are available for scheduling.
 		# HELP kube_node_status_condition The condition of a cluster node.
 		# TYPE kube_node_status_condition gauge
+		# HELP kube_node_status_condition_last_transition_time Unix timestamp of the last transition of a cluster node condition.
+		# TYPE kube_node_status_condition_last_transition_time gauge
+		# HELP kube_node_status_condition_last_heartbeat_time Unix timestamp of the last heartbeat received for a cluster node condition.
+		# TYPE kube_node_status_condition_last_heartbeat_time gauge
+		# HELP kube_node_role The role of a cluster node.
+		# TYPE kube_node_role gauge
+		# HELP kube_node_status_address The addresses of a cluster node. One series for each address.
+		# TYPE kube_node_status_address gauge
+		# HELP kube_node_status_volumes_attached The number of volumes attached to a cluster node.
+		# TYPE kube_node_status_volumes_attached gauge
+		# HELP kube_node_status_volume_attached_info The volumes attached to a cluster node. One series for each volume.
+		# TYPE kube_node_status_volume_attached_info gauge
+		# HELP kube_node_status_volumes_in_use The number of volumes in use by a cluster node.
+		# TYPE kube_node_status_volumes_in_use gauge
+		# HELP kube_node_status_images The number of container images on a cluster node.
+		# TYPE kube_node_status_images gauge
+		# HELP kube_node_status_images_size_bytes The total size of the container images on a cluster node in bytes.
+		# TYPE kube_node_status_images_size_bytes gauge
+		# HELP kube_node_status_kubelet_endpoint_port The port the kubelet of a cluster node is listening on.
+		# TYPE kube_node_status_kubelet_endpoint_port gauge
 	`
 	cases := []generateMetricsTestCase{
 		// Verify populating base metric and that metric for unset fields are skipped.
@@ -85,6 +105,10 @@ func TestNodeCollector(t *testing.T) {
 				kube_node_info{container_runtime_version="rkt",kernel_version="kernel",kubelet_version="kubelet",kubeproxy_version="kubeproxy",node="127.0.0.1",os_image="osimage",provider_id="provider://i-uniqueid"} 1
 				kube_node_labels{node="127.0.0.1"} 1
 				kube_node_spec_unschedulable{node="127.0.0.1"} 0
+				kube_node_status_images_size_bytes{node="127.0.0.1"} 0
+				kube_node_status_images{node="127.0.0.1"} 0
+				kube_node_status_volumes_attached{node="127.0.0.1"} 0
+				kube_node_status_volumes_in_use{node="127.0.0.1"} 0
 			`,
 		},
 		// Verify resource metric.
@@ -150,6 +174,10 @@ func TestNodeCollector(t *testing.T) {
         kube_node_status_capacity{node="127.0.0.1",resource="nvidia_com_gpu",unit="integer"} 4
         kube_node_status_capacity{node="127.0.0.1",resource="pods",unit="integer"} 1000
         kube_node_status_capacity{node="127.0.0.1",resource="storage",unit="byte"} 3e+09
+        kube_node_status_images_size_bytes{node="127.0.0.1"} 0
+        kube_node_status_images{node="127.0.0.1"} 0
+        kube_node_status_volumes_attached{node="127.0.0.1"} 0
+        kube_node_status_volumes_in_use{node="127.0.0.1"} 0
 			`,
 		},
 		// Verify phase enumerations.
@@ -280,6 +308,37 @@ func TestNodeCollector(t *testing.T) {
 			`,
 			MetricNames: []string{"kube_node_status_condition"},
 		},
+		// Verify condition timestamps.
+		{
+			Obj: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "127.0.0.1",
+				},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{
+							Type:               v1.NodeReady,
+							Status:             v1.ConditionUnknown,
+							Reason:             "NodeStatusUnknown",
+							LastTransitionTime: metav1.Time{Time: time.Unix(1500000000, 0)},
+							LastHeartbeatTime:  metav1.Time{Time: time.Unix(1499999000, 0)},
+						},
+						{
+							Type:               v1.NodeMemoryPressure,
+							Status:             v1.ConditionFalse,
+							Reason:             "KubeletHasSufficientMemory",
+							LastTransitionTime: metav1.Time{Time: time.Unix(1400000000, 0)},
+						},
+					},
+				},
+			},
+			Want: `
+				kube_node_status_condition_last_heartbeat_time{condition="Ready",node="127.0.0.1",reason="NodeStatusUnknown"} 1.499999e+09
+				kube_node_status_condition_last_transition_time{condition="MemoryPressure",node="127.0.0.1",reason="KubeletHasSufficientMemory"} 1.4e+09
+				kube_node_status_condition_last_transition_time{condition="Ready",node="127.0.0.1",reason="NodeStatusUnknown"} 1.5e+09
+			`,
+			MetricNames: []string{"kube_node_status_condition_last_transition_time", "kube_node_status_condition_last_heartbeat_time"},
+		},
 		// Verify SpecTaints
 		{
 			Obj: &v1.Node{
@@ -301,6 +360,62 @@ func TestNodeCollector(t *testing.T) {
 			`,
 			MetricNames: []string{"kube_node_spec_taint"},
 		},
+		// Verify roles, addresses, volumes and images.
+		{
+			Obj: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "127.0.0.1",
+					Labels: map[string]string{
+						"node-role.kubernetes.io/master": "",
+						"node-role.kubernetes.io/etcd":   "true",
+						"kubernetes.io/role":             "master",
+						"node-role.kubernetes.io":        "",
+					},
+				},
+				Status: v1.NodeStatus{
+					Addresses: []v1.NodeAddress{
+						{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+						{Type: v1.NodeHostName, Address: "node-1"},
+					},
+					VolumesAttached: []v1.AttachedVolume{
+						{Name: "kubernetes.io/csi/csi.example.com^vol-1", DevicePath: "/dev/xvdba"},
+						{Name: "kubernetes.io/csi/csi.example.com^vol-2"},
+					},
+					VolumesInUse: []v1.UniqueVolumeName{
+						"kubernetes.io/csi/csi.example.com^vol-1",
+					},
+					Images: []v1.ContainerImage{
+						{Names: []string{"k8s.gcr.io/pause:3.1"}, SizeBytes: 742472},
+						{Names: []string{"k8s.gcr.io/kube-proxy:v1.13.0"}, SizeBytes: 80220466},
+					},
+					DaemonEndpoints: v1.NodeDaemonEndpoints{
+						KubeletEndpoint: v1.DaemonEndpoint{Port: 10250},
+					},
+				},
+			},
+			Want: `
+				kube_node_role{node="127.0.0.1",role="etcd"} 1
+				kube_node_role{node="127.0.0.1",role="master"} 1
+				kube_node_status_address{address="10.0.0.1",node="127.0.0.1",type="InternalIP"} 1
+				kube_node_status_address{address="node-1",node="127.0.0.1",type="Hostname"} 1
+				kube_node_status_volumes_attached{node="127.0.0.1"} 2
+				kube_node_status_volume_attached_info{device_path="/dev/xvdba",node="127.0.0.1",volume="kubernetes.io/csi/csi.example.com^vol-1"} 1
+				kube_node_status_volume_attached_info{device_path="",node="127.0.0.1",volume="kubernetes.io/csi/csi.example.com^vol-2"} 1
+				kube_node_status_volumes_in_use{node="127.0.0.1"} 1
+				kube_node_status_images{node="127.0.0.1"} 2
+				kube_node_status_images_size_bytes{node="127.0.0.1"} 8.0962938e+07
+				kube_node_status_kubelet_endpoint_port{node="127.0.0.1"} 10250
+			`,
+			MetricNames: []string{
+				"kube_node_role",
+				"kube_node_status_address",
+				"kube_node_status_volumes_attached",
+				"kube_node_status_volume_attached_info",
+				"kube_node_status_volumes_in_use",
+				"kube_node_status_images",
+				"kube_node_status_kubelet_endpoint_port",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(nodeMetricFamilies)
@@ -0,0 +1,98 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+var (
+	descNodeAggregateLabelsDefaultLabels = []string{"node"}
+
+	nodeAggregateMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_node_pods_phase",
+			Type: metric.Gauge,
+			Help: "Number of pods scheduled to the node per phase.",
+			GenerateFunc: wrapNodeAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: podAggregatePhaseMetrics(a),
+				}
+			}),
+		},
+		{
+			Name: "kube_node_pod_resource_requests",
+			Type: metric.Gauge,
+			Help: "Sum of the effective resource requests of the non-terminated pods scheduled to the node, as accounted by the scheduler.",
+			GenerateFunc: wrapNodeAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: resourceMetrics(a.requests),
+				}
+			}),
+		},
+		{
+			Name: "kube_node_pod_resource_limits",
+			Type: metric.Gauge,
+			Help: "Sum of the effective resource limits of the non-terminated pods scheduled to the node.",
+			GenerateFunc: wrapNodeAggregateFunc(func(a *podAggregate) *metric.Family {
+				return &metric.Family{
+					Metrics: resourceMetrics(a.limits),
+				}
+			}),
+		},
+	}
+)
+
+func wrapNodeAggregateFunc(f func(*podAggregate) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		aggregate := obj.(*podAggregate)
+
+		metricFamily := f(aggregate)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descNodeAggregateLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{aggregate.key}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+// nodeAggregateOf returns the contribution of the given pod to the aggregate
+// of the node it is scheduled to, or nil if the pod is not scheduled yet. Like
+// the scheduler, only non-terminated pods are charged with their requests and
+// limits.
+func nodeAggregateOf(p *v1.Pod) *podAggregate {
+	if p.Spec.NodeName == "" {
+		return nil
+	}
+
+	a := newPodAggregate(p.Spec.NodeName)
+	a.pods = 1
+
+	if p.Status.Phase != "" {
+		a.phases[p.Status.Phase] = 1
+	}
+
+	if p.Status.Phase != v1.PodSucceeded && p.Status.Phase != v1.PodFailed {
+		a.requests, a.limits = podEffectiveResources(p)
+	}
+
+	return a
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+)
+
+func TestNodeAggregateStore(t *testing.T) {
+	s := newPodAggregateStore(
+		newPodControllerStore(
+			metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+			newOwnerIndex(),
+			nil,
+			metric.ComposeMetricGenFuncs(nil),
+		),
+		nodeAggregateOf,
+		metric.ExtractMetricFamilyHeaders(nodeAggregateMetricFamilies),
+		metric.ComposeMetricGenFuncs(nodeAggregateMetricFamilies),
+	)
+
+	scheduled := func(uid, node string, phase v1.PodPhase) *v1.Pod {
+		p := newAggregateTestPod(uid, "ns1", phase, "500m", 0)
+		p.Spec.NodeName = node
+		return p
+	}
+
+	// The init container requests more memory than the container, but less
+	// CPU, hence the pod is charged with the CPU of the container and the
+	// memory of the init container.
+	withInit := scheduled("pod2", "node1", v1.PodRunning)
+	withInit.Spec.InitContainers = []v1.Container{
+		{
+			Name: "init1",
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("1G"),
+				},
+			},
+		},
+	}
+
+	for _, p := range []*v1.Pod{
+		scheduled("pod1", "node1", v1.PodRunning),
+		withInit,
+		// Terminated pods are counted, but not charged with their resources.
+		scheduled("pod3", "node1", v1.PodSucceeded),
+		// Pods not scheduled yet are not accounted to any node.
+		scheduled("pod4", "", v1.PodPending),
+	} {
+		if err := s.Add(p); err != nil {
+			t.Fatalf("unexpected error adding pod: %v", err)
+		}
+	}
+
+	want := `
+		kube_node_pods_phase{node="node1",phase="Pending"} 0
+		kube_node_pods_phase{node="node1",phase="Running"} 2
+		kube_node_pods_phase{node="node1",phase="Succeeded"} 1
+		kube_node_pods_phase{node="node1",phase="Failed"} 0
+		kube_node_pods_phase{node="node1",phase="Unknown"} 0
+		kube_node_pod_resource_requests{node="node1",resource="cpu",unit="core"} 1
+		kube_node_pod_resource_requests{node="node1",resource="memory",unit="byte"} 1.1e+09
+		kube_node_pod_resource_limits{node="node1",resource="memory",unit="byte"} 4e+08
+	`
+
+	w := strings.Builder{}
+	s.WriteAll(&w)
+	out := strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_node_"}), "\n")
+
+	if err := compareOutput(want, out); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestPodEffectiveResources(t *testing.T) {
+	p := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{UID: types.UID("pod1")},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+						Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+					},
+				},
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+					},
+				},
+			},
+			Containers: []v1.Container{
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("500m"),
+							v1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+					},
+				},
+				{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("700m"),
+							v1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1500m")},
+					},
+				},
+			},
+		},
+	}
+
+	requests, limits := podEffectiveResources(p)
+
+	cpu := resourceKey{"cpu", "core"}
+	memory := resourceKey{"memory", "byte"}
+	gpu := resourceKey{"nvidia_com_gpu", "integer"}
+
+	if requests[cpu] != 2000 || requests[memory] != 2<<30 || requests[gpu] != 1 || len(requests) != 3 {
+		t.Errorf("unexpected effective requests: %v", requests)
+	}
+	if limits[cpu] != 2500 || len(limits) != 1 {
+		t.Errorf("unexpected effective limits: %v", limits)
+	}
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"io"
+	"sync"
+
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// maxOwnerChainDepth bounds the walk along the ownership chain, guarding
+// against reference cycles.
+const maxOwnerChainDepth = 10
+
+type ownerKey struct {
+	namespace string
+	kind      string
+	name      string
+}
+
+// ownerIndex maps intermediate owners, e.g. ReplicaSets and Jobs, to their own
+// controller, allowing to resolve the top-level controller of a pod.
+type ownerIndex struct {
+	// Protects controllers
+	mutex sync.RWMutex
+	// controllers is a map indexed by owner, containing the controller
+	// reference of the owner, or nil if the owner has no controller.
+	controllers map[ownerKey]*metav1.OwnerReference
+}
+
+func newOwnerIndex() *ownerIndex {
+	return &ownerIndex{
+		controllers: map[ownerKey]*metav1.OwnerReference{},
+	}
+}
+
+func (i *ownerIndex) add(kind string, obj interface{}) error {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.controllers[ownerKey{o.GetNamespace(), kind, o.GetName()}] = metav1.GetControllerOf(o)
+
+	return nil
+}
+
+func (i *ownerIndex) delete(kind string, obj interface{}) error {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	delete(i.controllers, ownerKey{o.GetNamespace(), kind, o.GetName()})
+
+	return nil
+}
+
+func (i *ownerIndex) replace(kind string, list []interface{}) error {
+	i.mutex.Lock()
+	for k := range i.controllers {
+		if k.kind == kind {
+			delete(i.controllers, k)
+		}
+	}
+	i.mutex.Unlock()
+
+	for _, o := range list {
+		if err := i.add(kind, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolve walks the ownership chain starting at the given controller
+// reference, returning the kind and name of the top-level controller. The
+// walk stops at the first owner which is not indexed or has no controller.
+func (i *ownerIndex) resolve(namespace string, ref *metav1.OwnerReference) (string, string) {
+	if ref == nil {
+		return "<none>", "<none>"
+	}
+
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	kind, name := ref.Kind, ref.Name
+	for depth := 0; depth < maxOwnerChainDepth; depth++ {
+		controller := i.controllers[ownerKey{namespace, kind, name}]
+		if controller == nil {
+			break
+		}
+		kind, name = controller.Kind, controller.Name
+	}
+
+	return kind, name
+}
+
+// ownerIndexStore wraps the store of a collector of intermediate owners,
+// keeping the ownerIndex up to date with the objects added to the store.
+type ownerIndexStore struct {
+	cache.Store
+
+	index *ownerIndex
+	kind  string
+}
+
+func newOwnerIndexStore(store cache.Store, index *ownerIndex, kind string) *ownerIndexStore {
+	return &ownerIndexStore{
+		Store: store,
+		index: index,
+		kind:  kind,
+	}
+}
+
+// Add adds the given object to the wrapped store and the ownerIndex.
+func (s *ownerIndexStore) Add(obj interface{}) error {
+	if err := s.Store.Add(obj); err != nil {
+		return err
+	}
+	return s.index.add(s.kind, obj)
+}
+
+// Update updates the given object in the wrapped store and the ownerIndex.
+func (s *ownerIndexStore) Update(obj interface{}) error {
+	if err := s.Store.Update(obj); err != nil {
+		return err
+	}
+	return s.index.add(s.kind, obj)
+}
+
+// Delete deletes the given object from the wrapped store and the ownerIndex.
+func (s *ownerIndexStore) Delete(obj interface{}) error {
+	if err := s.Store.Delete(obj); err != nil {
+		return err
+	}
+	return s.index.delete(s.kind, obj)
+}
+
+// Replace replaces the contents of the wrapped store and the entries of the
+// ownerIndex of the same kind with the given list.
+func (s *ownerIndexStore) Replace(list []interface{}, resourceVersion string) error {
+	if err := s.Store.Replace(list, resourceVersion); err != nil {
+		return err
+	}
+	return s.index.replace(s.kind, list)
+}
+
+// podController identifies a pod and the top-level controller it resolves to.
+type podController struct {
+	namespace string
+	pod       string
+	kind      string
+	name      string
+}
+
+// podControllerStore wraps the MetricsStore of the pod collector. Next to the
+// metrics of the wrapped store it exposes the top-level controller of each
+// pod, which is resolved on every scrape, as intermediate owners may change
+// independently of the pod.
+type podControllerStore struct {
+	*metricsstore.MetricsStore
+
+	// Protects controllers
+	mutex sync.RWMutex
+	// controllers is a map indexed by pod id, containing the namespace, name
+	// and controller reference of the pod.
+	controllers map[types.UID]podControllerRef
+
+	index               *ownerIndex
+	headers             []string
+	generateMetricsFunc func(interface{}) []metricsstore.FamilyStringer
+}
+
+type podControllerRef struct {
+	namespace string
+	pod       string
+	ref       *metav1.OwnerReference
+}
+
+func newPodControllerStore(
+	store *metricsstore.MetricsStore,
+	index *ownerIndex,
+	headers []string,
+	generateFunc func(interface{}) []metricsstore.FamilyStringer,
+) *podControllerStore {
+	return &podControllerStore{
+		MetricsStore:        store,
+		controllers:         map[types.UID]podControllerRef{},
+		index:               index,
+		headers:             headers,
+		generateMetricsFunc: generateFunc,
+	}
+}
+
+// Add adds the given pod to the wrapped store and records its controller.
+func (s *podControllerStore) Add(obj interface{}) error {
+	if err := s.MetricsStore.Add(obj); err != nil {
+		return err
+	}
+
+	p, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.controllers[p.UID] = podControllerRef{
+		namespace: p.Namespace,
+		pod:       p.Name,
+		ref:       metav1.GetControllerOf(p),
+	}
+
+	return nil
+}
+
+// Update updates the given pod in the wrapped store and its controller.
+func (s *podControllerStore) Update(obj interface{}) error {
+	return s.Add(obj)
+}
+
+// Delete deletes the given pod from the wrapped store and its controller.
+func (s *podControllerStore) Delete(obj interface{}) error {
+	if err := s.MetricsStore.Delete(obj); err != nil {
+		return err
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.controllers, o.GetUID())
+
+	return nil
+}
+
+// Replace will delete the contents of the store, using instead the
+// given list.
+func (s *podControllerStore) Replace(list []interface{}, _ string) error {
+	s.mutex.Lock()
+	s.controllers = map[types.UID]podControllerRef{}
+	s.mutex.Unlock()
+
+	// Reset the wrapped store, the pods of the list are added to both stores
+	// below.
+	if err := s.MetricsStore.Replace(nil, ""); err != nil {
+		return err
+	}
+
+	for _, o := range list {
+		if err := s.Add(o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteAll writes the metrics of the wrapped store, followed by the resolved
+// top-level controller of each pod, into the given writer.
+func (s *podControllerStore) WriteAll(w io.Writer) {
+	s.MetricsStore.WriteAll(w)
+
+	if len(s.headers) == 0 {
+		return
+	}
+
+	s.mutex.RLock()
+	families := make([][]metricsstore.FamilyStringer, 0, len(s.controllers))
+	for _, c := range s.controllers {
+		kind, name := s.index.resolve(c.namespace, c.ref)
+		families = append(families, s.generateMetricsFunc(&podController{
+			namespace: c.namespace,
+			pod:       c.pod,
+			kind:      kind,
+			name:      name,
+		}))
+	}
+	s.mutex.RUnlock()
+
+	for i, help := range s.headers {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		for _, f := range families {
+			w.Write([]byte(f[i].String()))
+		}
+	}
+}
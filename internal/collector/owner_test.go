@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+)
+
+func newControllerRef(kind, name string) []metav1.OwnerReference {
+	isController := true
+	return []metav1.OwnerReference{
+		{
+			Kind:       kind,
+			Name:       name,
+			Controller: &isController,
+		},
+	}
+}
+
+func TestPodControllerStore(t *testing.T) {
+	index := newOwnerIndex()
+	replicaSets := newOwnerIndexStore(
+		metricsstore.NewMetricsStore(
+			metric.ExtractMetricFamilyHeaders(replicaSetMetricFamilies),
+			metric.ComposeMetricGenFuncs(replicaSetMetricFamilies),
+		),
+		index,
+		"ReplicaSet",
+	)
+	pods := newPodControllerStore(
+		metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+		index,
+		metric.ExtractMetricFamilyHeaders(podControllerMetricFamilies),
+		metric.ComposeMetricGenFuncs(podControllerMetricFamilies),
+	)
+
+	scrape := func() string {
+		w := strings.Builder{}
+		pods.WriteAll(&w)
+		return strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_pod_controller"}), "\n")
+	}
+
+	rs := &v1beta1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "rs1-6b9f8d4c7",
+			Namespace:       "ns1",
+			UID:             "rs1",
+			OwnerReferences: newControllerRef("Deployment", "deploy1"),
+		},
+	}
+	for _, p := range []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod1",
+				Namespace:       "ns1",
+				UID:             "pod1",
+				OwnerReferences: newControllerRef("ReplicaSet", "rs1-6b9f8d4c7"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod2",
+				Namespace:       "ns1",
+				UID:             "pod2",
+				OwnerReferences: newControllerRef("ReplicaSet", "rs2-5d8c7b6f9"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod3",
+				Namespace: "ns1",
+				UID:       "pod3",
+			},
+		},
+	} {
+		if err := pods.Add(p); err != nil {
+			t.Fatalf("unexpected error adding pod: %v", err)
+		}
+	}
+
+	cases := []struct {
+		update func() error
+		want   string
+	}{
+		// Owners which are not indexed end the walk along the chain.
+		{
+			update: func() error { return nil },
+			want: `
+				kube_pod_controller{namespace="ns1",pod="pod1",controller_kind="ReplicaSet",controller_name="rs1-6b9f8d4c7"} 1
+				kube_pod_controller{namespace="ns1",pod="pod2",controller_kind="ReplicaSet",controller_name="rs2-5d8c7b6f9"} 1
+				kube_pod_controller{namespace="ns1",pod="pod3",controller_kind="<none>",controller_name="<none>"} 1
+			`,
+		},
+		{
+			update: func() error { return replicaSets.Add(rs) },
+			want: `
+				kube_pod_controller{namespace="ns1",pod="pod1",controller_kind="Deployment",controller_name="deploy1"} 1
+				kube_pod_controller{namespace="ns1",pod="pod2",controller_kind="ReplicaSet",controller_name="rs2-5d8c7b6f9"} 1
+				kube_pod_controller{namespace="ns1",pod="pod3",controller_kind="<none>",controller_name="<none>"} 1
+			`,
+		},
+		// Changes of intermediate owners are reflected without updating the
+		// pod.
+		{
+			update: func() error {
+				orphaned := rs.DeepCopy()
+				orphaned.OwnerReferences = nil
+				return replicaSets.Update(orphaned)
+			},
+			want: `
+				kube_pod_controller{namespace="ns1",pod="pod1",controller_kind="ReplicaSet",controller_name="rs1-6b9f8d4c7"} 1
+				kube_pod_controller{namespace="ns1",pod="pod2",controller_kind="ReplicaSet",controller_name="rs2-5d8c7b6f9"} 1
+				kube_pod_controller{namespace="ns1",pod="pod3",controller_kind="<none>",controller_name="<none>"} 1
+			`,
+		},
+		{
+			update: func() error {
+				if err := replicaSets.Replace([]interface{}{rs}, ""); err != nil {
+					return err
+				}
+				return pods.Delete(&v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: "pod3"}})
+			},
+			want: `
+				kube_pod_controller{namespace="ns1",pod="pod1",controller_kind="Deployment",controller_name="deploy1"} 1
+				kube_pod_controller{namespace="ns1",pod="pod2",controller_kind="ReplicaSet",controller_name="rs2-5d8c7b6f9"} 1
+			`,
+		},
+	}
+
+	for i, c := range cases {
+		if err := c.update(); err != nil {
+			t.Fatalf("unexpected error updating stores in %vth run: %v", i, err)
+		}
+		if err := compareOutput(c.want, scrape()); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
+
+func TestOwnerIndexResolve(t *testing.T) {
+	index := newOwnerIndex()
+	for _, o := range []*metav1.ObjectMeta{
+		{Name: "job1-1554721200", Namespace: "ns1", OwnerReferences: newControllerRef("CronJob", "job1")},
+		// A reference cycle must not hang the walk.
+		{Name: "loop", Namespace: "ns1", OwnerReferences: newControllerRef("Job", "loop")},
+	} {
+		if err := index.add("Job", o); err != nil {
+			t.Fatalf("unexpected error adding owner: %v", err)
+		}
+	}
+
+	cases := []struct {
+		namespace string
+		ref       *metav1.OwnerReference
+		kind      string
+		name      string
+	}{
+		{"ns1", &newControllerRef("Job", "job1-1554721200")[0], "CronJob", "job1"},
+		{"ns2", &newControllerRef("Job", "job1-1554721200")[0], "Job", "job1-1554721200"},
+		{"ns1", &newControllerRef("Job", "loop")[0], "Job", "loop"},
+		{"ns1", nil, "<none>", "<none>"},
+	}
+
+	for i, c := range cases {
+		kind, name := index.resolve(c.namespace, c.ref)
+		if kind != c.kind || name != c.name {
+			t.Errorf("expected %vth owner to resolve to %v/%v, got %v/%v", i, c.kind, c.name, kind, name)
+		}
+	}
+}
@@ -123,9 +123,163 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_persistentvolume_spec_reclaim_policy",
+			Type: metric.Gauge,
+			Help: "Reclaim policy of the persistentvolume.",
+			GenerateFunc: wrapPersistentVolumeFunc(func(p *v1.PersistentVolume) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if p.Spec.PersistentVolumeReclaimPolicy != "" {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"reclaim_policy"},
+						LabelValues: []string{string(p.Spec.PersistentVolumeReclaimPolicy)},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolume_spec_access_mode",
+			Type: metric.Gauge,
+			Help: "Access modes of the persistentvolume. One series for each access mode.",
+			GenerateFunc: wrapPersistentVolumeFunc(func(p *v1.PersistentVolume) *metric.Family {
+				ms := make([]*metric.Metric, len(p.Spec.AccessModes))
+
+				for i, mode := range p.Spec.AccessModes {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"access_mode"},
+						LabelValues: []string{string(mode)},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolume_spec_volume_mode",
+			Type: metric.Gauge,
+			Help: "Volume mode of the persistentvolume.",
+			GenerateFunc: wrapPersistentVolumeFunc(func(p *v1.PersistentVolume) *metric.Family {
+				// A persistentvolume without a volume mode is treated as
+				// having a filesystem.
+				volumeMode := v1.PersistentVolumeFilesystem
+				if p.Spec.VolumeMode != nil {
+					volumeMode = *p.Spec.VolumeMode
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"volume_mode"},
+							LabelValues: []string{string(volumeMode)},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolume_claim_ref",
+			Type: metric.Gauge,
+			Help: "Information about the persistentvolumeclaim bound to the persistentvolume.",
+			GenerateFunc: wrapPersistentVolumeFunc(func(p *v1.PersistentVolume) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if p.Spec.ClaimRef != nil {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"claim_namespace", "claim_name"},
+						LabelValues: []string{p.Spec.ClaimRef.Namespace, p.Spec.ClaimRef.Name},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolume_source_info",
+			Type: metric.Gauge,
+			Help: "Information about the volume source of the persistentvolume.",
+			GenerateFunc: wrapPersistentVolumeFunc(func(p *v1.PersistentVolume) *metric.Family {
+				sourceType, csiDriver, volumeID := persistentVolumeSource(p.Spec.PersistentVolumeSource)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"source_type", "csi_driver", "volume_id"},
+							LabelValues: []string{sourceType, csiDriver, volumeID},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
 	}
 )
 
+// persistentVolumeSource returns the type of the given volume source as named
+// in the Kubernetes API, the CSI driver in case of a CSI volume and the
+// identifier of the backing volume if known, e.g. the CSI volume handle or the
+// disk ID of an in-tree cloud provider volume.
+func persistentVolumeSource(s v1.PersistentVolumeSource) (string, string, string) {
+	switch {
+	case s.CSI != nil:
+		return "csi", s.CSI.Driver, s.CSI.VolumeHandle
+	case s.GCEPersistentDisk != nil:
+		return "gcePersistentDisk", "", s.GCEPersistentDisk.PDName
+	case s.AWSElasticBlockStore != nil:
+		return "awsElasticBlockStore", "", s.AWSElasticBlockStore.VolumeID
+	case s.AzureDisk != nil:
+		return "azureDisk", "", s.AzureDisk.DataDiskURI
+	case s.Cinder != nil:
+		return "cinder", "", s.Cinder.VolumeID
+	case s.VsphereVolume != nil:
+		return "vsphereVolume", "", s.VsphereVolume.VolumePath
+	case s.PhotonPersistentDisk != nil:
+		return "photonPersistentDisk", "", s.PhotonPersistentDisk.PdID
+	case s.PortworxVolume != nil:
+		return "portworxVolume", "", s.PortworxVolume.VolumeID
+	case s.HostPath != nil:
+		return "hostPath", "", ""
+	case s.Glusterfs != nil:
+		return "glusterfs", "", ""
+	case s.NFS != nil:
+		return "nfs", "", ""
+	case s.RBD != nil:
+		return "rbd", "", ""
+	case s.ISCSI != nil:
+		return "iscsi", "", ""
+	case s.CephFS != nil:
+		return "cephfs", "", ""
+	case s.FC != nil:
+		return "fc", "", ""
+	case s.Flocker != nil:
+		return "flocker", "", ""
+	case s.FlexVolume != nil:
+		return "flexVolume", "", ""
+	case s.AzureFile != nil:
+		return "azureFile", "", ""
+	case s.Quobyte != nil:
+		return "quobyte", "", ""
+	case s.ScaleIO != nil:
+		return "scaleIO", "", ""
+	case s.Local != nil:
+		return "local", "", ""
+	case s.StorageOS != nil:
+		return "storageos", "", ""
+	}
+	return "", "", ""
+}
+
 func wrapPersistentVolumeFunc(f func(*v1.PersistentVolume) *metric.Family) func(interface{}) *metric.Family {
 	return func(obj interface{}) *metric.Family {
 		persistentVolume := obj.(*v1.PersistentVolume)
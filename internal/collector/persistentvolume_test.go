@@ -37,7 +37,18 @@ func TestPersistentVolumeCollector(t *testing.T) {
 			# TYPE kube_persistentvolume_info gauge
 			# HELP kube_persistentvolume_capacity_bytes The size of the Persistentvolume in bytes.
 			# TYPE kube_persistentvolume_capacity_bytes gauge
+			# HELP kube_persistentvolume_spec_reclaim_policy Reclaim policy of the persistentvolume.
+			# TYPE kube_persistentvolume_spec_reclaim_policy gauge
+			# HELP kube_persistentvolume_spec_access_mode Access modes of the persistentvolume. One series for each access mode.
+			# TYPE kube_persistentvolume_spec_access_mode gauge
+			# HELP kube_persistentvolume_spec_volume_mode Volume mode of the persistentvolume.
+			# TYPE kube_persistentvolume_spec_volume_mode gauge
+			# HELP kube_persistentvolume_claim_ref Information about the persistentvolumeclaim bound to the persistentvolume.
+			# TYPE kube_persistentvolume_claim_ref gauge
+			# HELP kube_persistentvolume_source_info Information about the volume source of the persistentvolume.
+			# TYPE kube_persistentvolume_source_info gauge
 	`
+	blockVolumeMode := v1.PersistentVolumeBlock
 	cases := []generateMetricsTestCase{
 		// Verify phase enumerations.
 		{
@@ -220,6 +231,94 @@ func TestPersistentVolumeCollector(t *testing.T) {
 				`,
 			MetricNames: []string{"kube_persistentvolume_capacity_bytes"},
 		},
+		{
+			Obj: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pv-csi",
+				},
+				Spec: v1.PersistentVolumeSpec{
+					PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimRetain,
+					AccessModes: []v1.PersistentVolumeAccessMode{
+						v1.ReadWriteOnce,
+						v1.ReadOnlyMany,
+					},
+					VolumeMode: &blockVolumeMode,
+					ClaimRef: &v1.ObjectReference{
+						Namespace: "default",
+						Name:      "test-pvc",
+					},
+					PersistentVolumeSource: v1.PersistentVolumeSource{
+						CSI: &v1.CSIPersistentVolumeSource{
+							Driver:       "csi.example.com",
+							VolumeHandle: "vol-1234",
+						},
+					},
+				},
+			},
+			Want: `
+					kube_persistentvolume_spec_reclaim_policy{persistentvolume="test-pv-csi",reclaim_policy="Retain"} 1
+					kube_persistentvolume_spec_access_mode{access_mode="ReadWriteOnce",persistentvolume="test-pv-csi"} 1
+					kube_persistentvolume_spec_access_mode{access_mode="ReadOnlyMany",persistentvolume="test-pv-csi"} 1
+					kube_persistentvolume_spec_volume_mode{persistentvolume="test-pv-csi",volume_mode="Block"} 1
+					kube_persistentvolume_claim_ref{claim_name="test-pvc",claim_namespace="default",persistentvolume="test-pv-csi"} 1
+					kube_persistentvolume_source_info{csi_driver="csi.example.com",persistentvolume="test-pv-csi",source_type="csi",volume_id="vol-1234"} 1
+				`,
+			MetricNames: []string{
+				"kube_persistentvolume_spec_reclaim_policy",
+				"kube_persistentvolume_spec_access_mode",
+				"kube_persistentvolume_spec_volume_mode",
+				"kube_persistentvolume_claim_ref",
+				"kube_persistentvolume_source_info",
+			},
+		},
+		{
+			Obj: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pv-gce",
+				},
+				Spec: v1.PersistentVolumeSpec{
+					PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+					PersistentVolumeSource: v1.PersistentVolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "gce-disk-1",
+						},
+					},
+				},
+			},
+			Want: `
+					kube_persistentvolume_spec_reclaim_policy{persistentvolume="test-pv-gce",reclaim_policy="Delete"} 1
+					kube_persistentvolume_spec_volume_mode{persistentvolume="test-pv-gce",volume_mode="Filesystem"} 1
+					kube_persistentvolume_source_info{csi_driver="",persistentvolume="test-pv-gce",source_type="gcePersistentDisk",volume_id="gce-disk-1"} 1
+				`,
+			MetricNames: []string{
+				"kube_persistentvolume_spec_reclaim_policy",
+				"kube_persistentvolume_spec_access_mode",
+				"kube_persistentvolume_spec_volume_mode",
+				"kube_persistentvolume_claim_ref",
+				"kube_persistentvolume_source_info",
+			},
+		},
+		{
+			Obj: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pv-azure",
+				},
+				Spec: v1.PersistentVolumeSpec{
+					PersistentVolumeSource: v1.PersistentVolumeSource{
+						AzureDisk: &v1.AzureDiskVolumeSource{
+							DiskName:    "azure-disk-1",
+							DataDiskURI: "/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Compute/disks/azure-disk-1",
+						},
+					},
+				},
+			},
+			Want: `
+					kube_persistentvolume_source_info{csi_driver="",persistentvolume="test-pv-azure",source_type="azureDisk",volume_id="/subscriptions/sub-1/resourceGroups/rg-1/providers/Microsoft.Compute/disks/azure-disk-1"} 1
+				`,
+			MetricNames: []string{
+				"kube_persistentvolume_source_info",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(persistentVolumeMetricFamilies)
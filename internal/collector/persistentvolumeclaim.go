@@ -140,6 +140,68 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_persistentvolumeclaim_status_capacity_bytes",
+			Type: metric.Gauge,
+			Help: "The actual capacity of the volume bound to the persistent volume claim.",
+			GenerateFunc: wrapPersistentVolumeClaimFunc(func(p *v1.PersistentVolumeClaim) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if storage, ok := p.Status.Capacity[v1.ResourceStorage]; ok {
+					ms = append(ms, &metric.Metric{
+						Value: float64(storage.Value()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolumeclaim_status_condition",
+			Type: metric.Gauge,
+			Help: "The condition of the persistent volume claim, e.g. whether it is resizing.",
+			GenerateFunc: wrapPersistentVolumeClaimFunc(func(p *v1.PersistentVolumeClaim) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for _, c := range p.Status.Conditions {
+					conditionMetrics := addConditionMetrics(c.Status)
+					for _, m := range conditionMetrics {
+						m.LabelKeys = []string{"condition", "status"}
+						m.LabelValues = append([]string{string(c.Type)}, m.LabelValues...)
+					}
+					ms = append(ms, conditionMetrics...)
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_persistentvolumeclaim_spec_volume_mode",
+			Type: metric.Gauge,
+			Help: "The volume mode requested by the persistent volume claim.",
+			GenerateFunc: wrapPersistentVolumeClaimFunc(func(p *v1.PersistentVolumeClaim) *metric.Family {
+				// A persistent volume claim without a volume mode requests a
+				// volume with a filesystem.
+				volumeMode := v1.PersistentVolumeFilesystem
+				if p.Spec.VolumeMode != nil {
+					volumeMode = *p.Spec.VolumeMode
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"volume_mode"},
+							LabelValues: []string{string(volumeMode)},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
 	}
 )
 
@@ -39,8 +39,15 @@ func TestPersistentVolumeClaimCollector(t *testing.T) {
 		# TYPE kube_persistentvolumeclaim_resource_requests_storage_bytes gauge
 		# HELP kube_persistentvolumeclaim_access_mode The access mode of the persistent volume.
 		# TYPE kube_persistentvolumeclaim_access_mode gauge
+		# HELP kube_persistentvolumeclaim_status_capacity_bytes The actual capacity of the volume bound to the persistent volume claim.
+		# TYPE kube_persistentvolumeclaim_status_capacity_bytes gauge
+		# HELP kube_persistentvolumeclaim_status_condition The condition of the persistent volume claim, e.g. whether it is resizing.
+		# TYPE kube_persistentvolumeclaim_status_condition gauge
+		# HELP kube_persistentvolumeclaim_spec_volume_mode The volume mode requested by the persistent volume claim.
+		# TYPE kube_persistentvolumeclaim_spec_volume_mode gauge
 	`
 	storageClassName := "rbd"
+	blockVolumeMode := v1.PersistentVolumeBlock
 	cases := []generateMetricsTestCase{
 		// Verify phase enumerations.
 		{
@@ -130,6 +137,48 @@ func TestPersistentVolumeClaimCollector(t *testing.T) {
 `,
 			MetricNames: []string{"kube_persistentvolumeclaim_info", "kube_persistentvolumeclaim_status_phase", "kube_persistentvolumeclaim_resource_requests_storage_bytes", "kube_persistentvolumeclaim_labels", "kube_persistentvolumeclaim_access_mode"},
 		},
+		{
+			Obj: &v1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "postgres-data",
+					Namespace: "default",
+					Annotations: map[string]string{
+						v1.BetaStorageClassAnnotation: "ssd",
+					},
+				},
+				Spec: v1.PersistentVolumeClaimSpec{
+					VolumeMode: &blockVolumeMode,
+					VolumeName: "pvc-postgres-data",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceStorage: resource.MustParse("2Gi"),
+						},
+					},
+				},
+				Status: v1.PersistentVolumeClaimStatus{
+					Phase: v1.ClaimBound,
+					Capacity: v1.ResourceList{
+						v1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+					Conditions: []v1.PersistentVolumeClaimCondition{
+						{
+							Type:   v1.PersistentVolumeClaimFileSystemResizePending,
+							Status: v1.ConditionTrue,
+						},
+					},
+				},
+			},
+			Want: `
+				kube_persistentvolumeclaim_info{namespace="default",persistentvolumeclaim="postgres-data",storageclass="ssd",volumename="pvc-postgres-data"} 1
+				kube_persistentvolumeclaim_resource_requests_storage_bytes{namespace="default",persistentvolumeclaim="postgres-data"} 2.147483648e+09
+				kube_persistentvolumeclaim_status_capacity_bytes{namespace="default",persistentvolumeclaim="postgres-data"} 1.073741824e+09
+				kube_persistentvolumeclaim_status_condition{condition="FileSystemResizePending",namespace="default",persistentvolumeclaim="postgres-data",status="false"} 0
+				kube_persistentvolumeclaim_status_condition{condition="FileSystemResizePending",namespace="default",persistentvolumeclaim="postgres-data",status="true"} 1
+				kube_persistentvolumeclaim_status_condition{condition="FileSystemResizePending",namespace="default",persistentvolumeclaim="postgres-data",status="unknown"} 0
+				kube_persistentvolumeclaim_spec_volume_mode{namespace="default",persistentvolumeclaim="postgres-data",volume_mode="Block"} 1
+`,
+			MetricNames: []string{"kube_persistentvolumeclaim_info", "kube_persistentvolumeclaim_resource_requests_storage_bytes", "kube_persistentvolumeclaim_status_capacity_bytes", "kube_persistentvolumeclaim_status_condition", "kube_persistentvolumeclaim_spec_volume_mode"},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(persistentVolumeClaimMetricFamilies)
@@ -610,6 +610,30 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_pod_resource_requests",
+			Type: metric.Gauge,
+			Help: "The effective resources requested by a pod as accounted by the scheduler, the larger of the sum over all containers and the maximum over all init containers.",
+			GenerateFunc: wrapPodFunc(func(p *v1.Pod) *metric.Family {
+				requests, _ := podEffectiveResources(p)
+
+				return &metric.Family{
+					Metrics: podEffectiveResourceMetrics(p, requests),
+				}
+			}),
+		},
+		{
+			Name: "kube_pod_resource_limits",
+			Type: metric.Gauge,
+			Help: "The effective resource limits of a pod, the larger of the sum over all containers and the maximum over all init containers.",
+			GenerateFunc: wrapPodFunc(func(p *v1.Pod) *metric.Family {
+				_, limits := podEffectiveResources(p)
+
+				return &metric.Family{
+					Metrics: podEffectiveResourceMetrics(p, limits),
+				}
+			}),
+		},
 		{
 			Name: "kube_pod_container_resource_requests_cpu_cores",
 			Type: metric.Gauge,
@@ -748,8 +772,40 @@ var (
 			}),
 		},
 	}
+
+	podControllerMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_pod_controller",
+			Type: metric.Gauge,
+			Help: "Information about the top-level controller of the Pod, resolved along the chain of controlling owners.",
+			GenerateFunc: wrapPodControllerFunc(func(c *podController) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"controller_kind", "controller_name"},
+							LabelValues: []string{c.kind, c.name},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+	}
 )
 
+// podEffectiveResourceMetrics returns one metric per resource of the given
+// effective requests or limits of a pod.
+func podEffectiveResourceMetrics(p *v1.Pod, resources map[resourceKey]int64) []*metric.Metric {
+	ms := resourceMetrics(resources)
+
+	for _, m := range ms {
+		m.LabelKeys = append([]string{"node"}, m.LabelKeys...)
+		m.LabelValues = append([]string{p.Spec.NodeName}, m.LabelValues...)
+	}
+
+	return ms
+}
+
 func wrapPodFunc(f func(*v1.Pod) *metric.Family) func(interface{}) *metric.Family {
 	return func(obj interface{}) *metric.Family {
 		pod := obj.(*v1.Pod)
@@ -765,6 +821,21 @@ func wrapPodFunc(f func(*v1.Pod) *metric.Family) func(interface{}) *metric.Famil
 	}
 }
 
+func wrapPodControllerFunc(f func(*podController) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		c := obj.(*podController)
+
+		metricFamily := f(c)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descPodLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{c.namespace, c.pod}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
 func createPodListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
 	return cache.ListWatch{
 		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
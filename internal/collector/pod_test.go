@@ -79,6 +79,10 @@ func TestPodCollector(t *testing.T) {
 	// # TYPE kube_pod_container_resource_requests gauge
 	// # HELP kube_pod_container_resource_limits The number of requested limit resource by a container.
 	// # TYPE kube_pod_container_resource_limits gauge
+	// # HELP kube_pod_resource_requests The effective resources requested by a pod as accounted by the scheduler, the larger of the sum over all containers and the maximum over all init containers.
+	// # TYPE kube_pod_resource_requests gauge
+	// # HELP kube_pod_resource_limits The effective resource limits of a pod, the larger of the sum over all containers and the maximum over all init containers.
+	// # TYPE kube_pod_resource_limits gauge
 	// # HELP kube_pod_container_resource_requests_cpu_cores The number of requested cpu cores by a container.
 	// # TYPE kube_pod_container_resource_requests_cpu_cores gauge
 	// # HELP kube_pod_container_resource_requests_memory_bytes The number of requested memory bytes by a container.
@@ -1053,6 +1057,66 @@ kube_pod_container_status_last_terminated_reason{container="container7",namespac
 				"kube_pod_spec_volumes_persistentvolumeclaims_readonly",
 			},
 		},
+		{
+			Obj: &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "ns1",
+				},
+				Spec: v1.PodSpec{
+					NodeName: "node1",
+					InitContainers: []v1.Container{
+						{
+							Name: "pod1_init1",
+							Resources: v1.ResourceRequirements{
+								Requests: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceCPU:    resource.MustParse("100m"),
+									v1.ResourceMemory: resource.MustParse("500M"),
+								},
+								Limits: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceMemory: resource.MustParse("500M"),
+								},
+							},
+						},
+					},
+					Containers: []v1.Container{
+						{
+							Name: "pod1_con1",
+							Resources: v1.ResourceRequirements{
+								Requests: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceCPU:    resource.MustParse("200m"),
+									v1.ResourceMemory: resource.MustParse("100M"),
+								},
+								Limits: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceMemory: resource.MustParse("200M"),
+								},
+							},
+						},
+						{
+							Name: "pod1_con2",
+							Resources: v1.ResourceRequirements{
+								Requests: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceCPU:    resource.MustParse("300m"),
+									v1.ResourceMemory: resource.MustParse("200M"),
+								},
+								Limits: map[v1.ResourceName]resource.Quantity{
+									v1.ResourceMemory: resource.MustParse("200M"),
+								},
+							},
+						},
+					},
+				},
+			},
+			Want: `
+				kube_pod_resource_requests{namespace="ns1",node="node1",pod="pod1",resource="cpu",unit="core"} 0.5
+				kube_pod_resource_requests{namespace="ns1",node="node1",pod="pod1",resource="memory",unit="byte"} 5e+08
+				kube_pod_resource_limits{namespace="ns1",node="node1",pod="pod1",resource="memory",unit="byte"} 5e+08
+			`,
+			MetricNames: []string{
+				"kube_pod_resource_requests",
+				"kube_pod_resource_limits",
+			},
+		},
 	}
 
 	for i, c := range cases {
@@ -0,0 +1,237 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"io"
+	"sync"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var podAggregatePhases = []v1.PodPhase{v1.PodPending, v1.PodRunning, v1.PodSucceeded, v1.PodFailed, v1.PodUnknown}
+
+// podAggregate holds the contribution of a single pod, or the sum of the
+// contributions of all pods sharing the same key, e.g. namespace or node.
+type podAggregate struct {
+	key      string
+	pods     int64
+	phases   map[v1.PodPhase]int64
+	requests map[resourceKey]int64
+	limits   map[resourceKey]int64
+	restarts int64
+}
+
+func newPodAggregate(key string) *podAggregate {
+	return &podAggregate{
+		key:      key,
+		phases:   map[v1.PodPhase]int64{},
+		requests: map[resourceKey]int64{},
+		limits:   map[resourceKey]int64{},
+	}
+}
+
+// add adds the contribution of a pod to the aggregate if sign is 1, or
+// subtracts it if sign is -1.
+func (a *podAggregate) add(c *podAggregate, sign int64) {
+	a.pods += sign * c.pods
+	for k, v := range c.phases {
+		a.phases[k] += sign * v
+	}
+	for k, v := range c.requests {
+		a.requests[k] += sign * v
+	}
+	for k, v := range c.limits {
+		a.limits[k] += sign * v
+	}
+	a.restarts += sign * c.restarts
+}
+
+func podAggregatePhaseMetrics(a *podAggregate) []*metric.Metric {
+	ms := make([]*metric.Metric, len(podAggregatePhases))
+
+	for i, phase := range podAggregatePhases {
+		ms[i] = &metric.Metric{
+			LabelKeys:   []string{"phase"},
+			LabelValues: []string{string(phase)},
+			Value:       float64(a.phases[phase]),
+		}
+	}
+
+	return ms
+}
+
+// podAggregateStore wraps the store of the pod collector. Next to the metrics
+// of the wrapped store it exposes aggregates of all pods sharing the same key,
+// which are updated incrementally with every pod added, updated or deleted.
+type podAggregateStore struct {
+	podStore
+
+	// Protects pods and aggregates
+	mutex sync.RWMutex
+	// pods is a map indexed by pod id, containing the contribution of the pod
+	// to the aggregate of its key.
+	pods map[types.UID]*podAggregate
+	// aggregates is a map indexed by key, containing the aggregate of all pods
+	// sharing the key.
+	aggregates map[string]*podAggregate
+	// aggregateFunc returns the contribution of the given pod, or nil if the
+	// pod does not contribute to any aggregate.
+	aggregateFunc func(*v1.Pod) *podAggregate
+
+	headers             []string
+	generateMetricsFunc func(interface{}) []metricsstore.FamilyStringer
+}
+
+func newPodAggregateStore(
+	store podStore,
+	aggregateFunc func(*v1.Pod) *podAggregate,
+	headers []string,
+	generateFunc func(interface{}) []metricsstore.FamilyStringer,
+) *podAggregateStore {
+	return &podAggregateStore{
+		podStore:            store,
+		pods:                map[types.UID]*podAggregate{},
+		aggregates:          map[string]*podAggregate{},
+		aggregateFunc:       aggregateFunc,
+		headers:             headers,
+		generateMetricsFunc: generateFunc,
+	}
+}
+
+// Add adds the given pod to the wrapped store and the aggregate of its key.
+func (s *podAggregateStore) Add(obj interface{}) error {
+	if err := s.podStore.Add(obj); err != nil {
+		return err
+	}
+
+	p, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remove(p.UID)
+
+	c := s.aggregateFunc(p)
+	if c == nil {
+		return nil
+	}
+	a, ok := s.aggregates[c.key]
+	if !ok {
+		a = newPodAggregate(c.key)
+		s.aggregates[c.key] = a
+	}
+	a.add(c, 1)
+	s.pods[p.UID] = c
+
+	return nil
+}
+
+// Update updates the given pod in the wrapped store and the aggregate of its
+// key.
+func (s *podAggregateStore) Update(obj interface{}) error {
+	return s.Add(obj)
+}
+
+// Delete deletes the given pod from the wrapped store and the aggregate of its
+// key.
+func (s *podAggregateStore) Delete(obj interface{}) error {
+	if err := s.podStore.Delete(obj); err != nil {
+		return err
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.remove(o.GetUID())
+
+	return nil
+}
+
+// remove subtracts the contribution of the given pod from the aggregate of its
+// key, dropping the aggregate once no pods are left. Callers need to hold the
+// mutex.
+func (s *podAggregateStore) remove(uid types.UID) {
+	c, ok := s.pods[uid]
+	if !ok {
+		return
+	}
+	delete(s.pods, uid)
+
+	a := s.aggregates[c.key]
+	a.add(c, -1)
+	if a.pods == 0 {
+		delete(s.aggregates, c.key)
+	}
+}
+
+// Replace will delete the contents of the store, using instead the
+// given list.
+func (s *podAggregateStore) Replace(list []interface{}, resourceVersion string) error {
+	s.mutex.Lock()
+	s.pods = map[types.UID]*podAggregate{}
+	s.aggregates = map[string]*podAggregate{}
+	s.mutex.Unlock()
+
+	// Reset the wrapped store, the pods of the list are added to both stores
+	// below.
+	if err := s.podStore.Replace(nil, resourceVersion); err != nil {
+		return err
+	}
+
+	for _, o := range list {
+		if err := s.Add(o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteAll writes the metrics of the wrapped store, followed by the aggregates
+// of each key, into the given writer.
+func (s *podAggregateStore) WriteAll(w io.Writer) {
+	s.podStore.WriteAll(w)
+
+	s.mutex.RLock()
+	families := make([][]metricsstore.FamilyStringer, 0, len(s.aggregates))
+	for _, a := range s.aggregates {
+		families = append(families, s.generateMetricsFunc(a))
+	}
+	s.mutex.RUnlock()
+
+	for i, help := range s.headers {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		for _, f := range families {
+			w.Write([]byte(f[i].String()))
+		}
+	}
+}
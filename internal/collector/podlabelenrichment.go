@@ -0,0 +1,433 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	podLabelIndexKindNode      = "Node"
+	podLabelIndexKindNamespace = "Namespace"
+)
+
+// podLabelIndex holds the selected labels of nodes and namespaces, which are
+// copied onto the metrics of the pods running on the node or in the
+// namespace.
+type podLabelIndex struct {
+	nodeLabelKeys      []string
+	namespaceLabelKeys []string
+
+	// Protects nodes and namespaces
+	mutex sync.RWMutex
+	// nodes and namespaces are maps indexed by object name, containing the
+	// values of the selected labels in the order of the configured keys.
+	nodes      map[string][]string
+	namespaces map[string][]string
+}
+
+func newPodLabelIndex(nodeLabelKeys, namespaceLabelKeys []string) *podLabelIndex {
+	return &podLabelIndex{
+		nodeLabelKeys:      nodeLabelKeys,
+		namespaceLabelKeys: namespaceLabelKeys,
+		nodes:              map[string][]string{},
+		namespaces:         map[string][]string{},
+	}
+}
+
+// entries returns the map and the configured label keys of the given kind.
+// Callers need to hold the mutex.
+func (i *podLabelIndex) entries(kind string) (map[string][]string, []string) {
+	if kind == podLabelIndexKindNode {
+		return i.nodes, i.nodeLabelKeys
+	}
+	return i.namespaces, i.namespaceLabelKeys
+}
+
+func selectLabelValues(labels map[string]string, keys []string) []string {
+	values := make([]string, len(keys))
+	for n, k := range keys {
+		values[n] = labels[k]
+	}
+	return values
+}
+
+func (i *podLabelIndex) add(kind string, obj interface{}) error {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	m, keys := i.entries(kind)
+	m[o.GetName()] = selectLabelValues(o.GetLabels(), keys)
+
+	return nil
+}
+
+func (i *podLabelIndex) delete(kind string, obj interface{}) error {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	m, _ := i.entries(kind)
+	delete(m, o.GetName())
+
+	return nil
+}
+
+func (i *podLabelIndex) replace(kind string, list []interface{}) error {
+	i.mutex.RLock()
+	_, keys := i.entries(kind)
+	i.mutex.RUnlock()
+
+	entries := make(map[string][]string, len(list))
+	for _, obj := range list {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		entries[o.GetName()] = selectLabelValues(o.GetLabels(), keys)
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if kind == podLabelIndexKindNode {
+		i.nodes = entries
+	} else {
+		i.namespaces = entries
+	}
+
+	return nil
+}
+
+// labels returns the Prometheus label keys and values of the selected labels
+// of the given node and namespace. Labels missing on the node or namespace,
+// or of a node or namespace not known yet, have an empty value.
+func (i *podLabelIndex) labels(node, namespace string) ([]string, []string) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	keys := make([]string, 0, len(i.nodeLabelKeys)+len(i.namespaceLabelKeys))
+	values := make([]string, 0, cap(keys))
+
+	nodeValues := i.nodes[node]
+	for n, k := range i.nodeLabelKeys {
+		keys = append(keys, "node_label_"+sanitizeLabelName(k))
+		if nodeValues != nil {
+			values = append(values, nodeValues[n])
+		} else {
+			values = append(values, "")
+		}
+	}
+
+	namespaceValues := i.namespaces[namespace]
+	for n, k := range i.namespaceLabelKeys {
+		keys = append(keys, "namespace_label_"+sanitizeLabelName(k))
+		if namespaceValues != nil {
+			values = append(values, namespaceValues[n])
+		} else {
+			values = append(values, "")
+		}
+	}
+
+	return keys, values
+}
+
+// ValidatePodLabelEnrichment checks the node and namespace labels to copy onto
+// pod metrics and the pod metrics to copy them onto. It rejects labels whose
+// collector is not among the given enabled collectors, as nothing would fill
+// them, labels that result in the same Prometheus label name and metrics that
+// are not exposed by the pod collector.
+func ValidatePodLabelEnrichment(enabledCollectors, nodeLabels, namespaceLabels, enrichmentMetrics []string) error {
+	seen := map[string]string{}
+	for _, l := range []struct {
+		prefix    string
+		keys      []string
+		collector string
+	}{
+		{"node_label_", nodeLabels, "nodes"},
+		{"namespace_label_", namespaceLabels, "namespaces"},
+	} {
+		if len(l.keys) > 0 && !isEnabledCollector(l.collector, enabledCollectors) {
+			return fmt.Errorf("copying %s labels onto pod metrics requires the %s collector to be enabled", strings.TrimSuffix(l.prefix, "_label_"), l.collector)
+		}
+		for _, k := range l.keys {
+			name := l.prefix + sanitizeLabelName(k)
+			if other, ok := seen[name]; ok {
+				return fmt.Errorf("labels %q and %q both result in the pod metric label %q", other, k, name)
+			}
+			seen[name] = k
+		}
+	}
+
+	for _, m := range enrichmentMetrics {
+		known := false
+		for _, f := range podMetricFamilies {
+			if f.Name == m {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%q is not a pod metric", m)
+		}
+	}
+
+	return nil
+}
+
+func isEnabledCollector(collector string, enabledCollectors []string) bool {
+	for _, c := range enabledCollectors {
+		if c == collector {
+			return true
+		}
+	}
+	return false
+}
+
+// isPodLabelEnrichmentMetric returns whether the given pod metric family is
+// one of the families selected for label enrichment.
+func isPodLabelEnrichmentMetric(name string, enrichmentMetrics []string) bool {
+	for _, m := range enrichmentMetrics {
+		if name == m {
+			return true
+		}
+	}
+	return false
+}
+
+// podLabelIndexStore wraps the store of the node or namespace collector,
+// keeping the podLabelIndex up to date with the objects added to the store.
+type podLabelIndexStore struct {
+	cache.Store
+
+	index *podLabelIndex
+	kind  string
+}
+
+func newPodLabelIndexStore(store cache.Store, index *podLabelIndex, kind string) *podLabelIndexStore {
+	return &podLabelIndexStore{
+		Store: store,
+		index: index,
+		kind:  kind,
+	}
+}
+
+// Add adds the given object to the wrapped store and the podLabelIndex.
+func (s *podLabelIndexStore) Add(obj interface{}) error {
+	if err := s.Store.Add(obj); err != nil {
+		return err
+	}
+	return s.index.add(s.kind, obj)
+}
+
+// Update updates the given object in the wrapped store and the podLabelIndex.
+func (s *podLabelIndexStore) Update(obj interface{}) error {
+	if err := s.Store.Update(obj); err != nil {
+		return err
+	}
+	return s.index.add(s.kind, obj)
+}
+
+// Delete deletes the given object from the wrapped store and the
+// podLabelIndex.
+func (s *podLabelIndexStore) Delete(obj interface{}) error {
+	if err := s.Store.Delete(obj); err != nil {
+		return err
+	}
+	return s.index.delete(s.kind, obj)
+}
+
+// Replace replaces the contents of the wrapped store and the entries of the
+// podLabelIndex of the same kind with the given list.
+func (s *podLabelIndexStore) Replace(list []interface{}, resourceVersion string) error {
+	if err := s.Store.Replace(list, resourceVersion); err != nil {
+		return err
+	}
+	return s.index.replace(s.kind, list)
+}
+
+// podStore is the store of the pod collector.
+type podStore interface {
+	cache.Store
+	WriteAll(io.Writer)
+}
+
+// podLabelEnrichmentStore wraps the store of the pod collector. Next to the
+// metrics of the wrapped store it exposes the pod metric families selected for
+// label enrichment, with the selected labels of the node and namespace of the
+// pod appended on every scrape, as these labels may change independently of
+// the pod.
+type podLabelEnrichmentStore struct {
+	podStore
+
+	// Protects pods
+	mutex sync.RWMutex
+	// pods is a map indexed by pod id, containing the node and namespace of
+	// the pod and its metrics of the enriched families, without the node and
+	// namespace labels.
+	pods map[types.UID]podLabelEnrichment
+
+	index    *podLabelIndex
+	headers  []string
+	families []metric.FamilyGenerator
+}
+
+type podLabelEnrichment struct {
+	node      string
+	namespace string
+	families  []*metric.Family
+}
+
+func newPodLabelEnrichmentStore(store podStore, index *podLabelIndex, families []metric.FamilyGenerator) *podLabelEnrichmentStore {
+	return &podLabelEnrichmentStore{
+		podStore: store,
+		pods:     map[types.UID]podLabelEnrichment{},
+		index:    index,
+		headers:  metric.ExtractMetricFamilyHeaders(families),
+		families: families,
+	}
+}
+
+// Add adds the given pod to the wrapped store and records its enriched
+// metrics.
+func (s *podLabelEnrichmentStore) Add(obj interface{}) error {
+	if err := s.podStore.Add(obj); err != nil {
+		return err
+	}
+
+	p, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil
+	}
+
+	e := podLabelEnrichment{
+		node:      p.Spec.NodeName,
+		namespace: p.Namespace,
+		families:  make([]*metric.Family, len(s.families)),
+	}
+	for i, f := range s.families {
+		e.families[i] = f.Generate(p)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.pods[p.UID] = e
+
+	return nil
+}
+
+// Update updates the given pod in the wrapped store and its enriched metrics.
+func (s *podLabelEnrichmentStore) Update(obj interface{}) error {
+	return s.Add(obj)
+}
+
+// Delete deletes the given pod from the wrapped store and its enriched
+// metrics.
+func (s *podLabelEnrichmentStore) Delete(obj interface{}) error {
+	if err := s.podStore.Delete(obj); err != nil {
+		return err
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.pods, o.GetUID())
+
+	return nil
+}
+
+// Replace replaces the contents of the wrapped store with the given list.
+func (s *podLabelEnrichmentStore) Replace(list []interface{}, resourceVersion string) error {
+	s.mutex.Lock()
+	s.pods = map[types.UID]podLabelEnrichment{}
+	s.mutex.Unlock()
+
+	// Reset the wrapped store, the pods of the list are added to both stores
+	// below.
+	if err := s.podStore.Replace(nil, resourceVersion); err != nil {
+		return err
+	}
+
+	for _, o := range list {
+		if err := s.Add(o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteAll writes the metrics of the wrapped store, followed by the enriched
+// metrics of each pod, into the given writer.
+func (s *podLabelEnrichmentStore) WriteAll(w io.Writer) {
+	s.podStore.WriteAll(w)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for i, help := range s.headers {
+		w.Write([]byte(help))
+		w.Write([]byte{'\n'})
+		for _, e := range s.pods {
+			keys, values := s.index.labels(e.node, e.namespace)
+			w.Write([]byte(enrichFamily(e.families[i], keys, values).String()))
+		}
+	}
+}
+
+// enrichFamily returns a copy of the given family with the given labels
+// appended to each of its metrics.
+func enrichFamily(f *metric.Family, keys, values []string) *metric.Family {
+	enriched := &metric.Family{
+		Name:    f.Name,
+		Metrics: make([]*metric.Metric, len(f.Metrics)),
+	}
+	for n, m := range f.Metrics {
+		enriched.Metrics[n] = &metric.Metric{
+			LabelKeys:   append(append(make([]string, 0, len(m.LabelKeys)+len(keys)), m.LabelKeys...), keys...),
+			LabelValues: append(append(make([]string, 0, len(m.LabelValues)+len(values)), m.LabelValues...), values...),
+			Value:       m.Value,
+		}
+	}
+
+	return enriched
+}
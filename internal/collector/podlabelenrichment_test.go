@@ -0,0 +1,226 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+	metricsstore "k8s.io/kube-state-metrics/pkg/metrics_store"
+)
+
+func TestPodLabelEnrichment(t *testing.T) {
+	index := newPodLabelIndex([]string{"topology.kubernetes.io/zone"}, []string{"team"})
+
+	nodes := newPodLabelIndexStore(
+		metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+		index,
+		podLabelIndexKindNode,
+	)
+	namespaces := newPodLabelIndexStore(
+		metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+		index,
+		podLabelIndexKindNamespace,
+	)
+
+	families := []metric.FamilyGenerator{}
+	for _, f := range podMetricFamilies {
+		if f.Name == "kube_pod_info" {
+			families = append(families, f)
+		}
+	}
+	pods := newPodLabelEnrichmentStore(
+		newPodControllerStore(
+			metricsstore.NewMetricsStore(nil, metric.ComposeMetricGenFuncs(nil)),
+			newOwnerIndex(),
+			nil,
+			metric.ComposeMetricGenFuncs(nil),
+		),
+		index,
+		families,
+	)
+
+	scrape := func() string {
+		w := strings.Builder{}
+		pods.WriteAll(&w)
+		return strings.Join(filterMetrics(strings.Split(w.String(), "\n"), []string{"kube_pod_info"}), "\n")
+	}
+
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node1",
+			UID:    "node1",
+			Labels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+		},
+	}
+
+	cases := []struct {
+		update func() error
+		want   string
+	}{
+		// Nodes and namespaces not known yet result in empty labels.
+		{
+			update: func() error {
+				return pods.Add(&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod1",
+						Namespace: "ns1",
+						UID:       "uid1",
+					},
+					Spec: v1.PodSpec{
+						NodeName: "node1",
+					},
+				})
+			},
+			want: `
+				kube_pod_info{namespace="ns1",pod="pod1",host_ip="",pod_ip="",uid="uid1",node="node1",created_by_kind="<none>",created_by_name="<none>",node_label_topology_kubernetes_io_zone="",namespace_label_team=""} 1
+			`,
+		},
+		{
+			update: func() error { return nodes.Add(node) },
+			want: `
+				kube_pod_info{namespace="ns1",pod="pod1",host_ip="",pod_ip="",uid="uid1",node="node1",created_by_kind="<none>",created_by_name="<none>",node_label_topology_kubernetes_io_zone="zone-a",namespace_label_team=""} 1
+			`,
+		},
+		// Label changes of a node are reflected by the pods running on it.
+		{
+			update: func() error {
+				relabeled := node.DeepCopy()
+				relabeled.Labels["topology.kubernetes.io/zone"] = "zone-b"
+				return nodes.Update(relabeled)
+			},
+			want: `
+				kube_pod_info{namespace="ns1",pod="pod1",host_ip="",pod_ip="",uid="uid1",node="node1",created_by_kind="<none>",created_by_name="<none>",node_label_topology_kubernetes_io_zone="zone-b",namespace_label_team=""} 1
+			`,
+		},
+		{
+			update: func() error {
+				return namespaces.Replace([]interface{}{
+					&v1.Namespace{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "ns1",
+							UID:    "ns1",
+							Labels: map[string]string{"team": "payments"},
+						},
+					},
+				}, "")
+			},
+			want: `
+				kube_pod_info{namespace="ns1",pod="pod1",host_ip="",pod_ip="",uid="uid1",node="node1",created_by_kind="<none>",created_by_name="<none>",node_label_topology_kubernetes_io_zone="zone-b",namespace_label_team="payments"} 1
+			`,
+		},
+		{
+			update: func() error { return nodes.Delete(node) },
+			want: `
+				kube_pod_info{namespace="ns1",pod="pod1",host_ip="",pod_ip="",uid="uid1",node="node1",created_by_kind="<none>",created_by_name="<none>",node_label_topology_kubernetes_io_zone="",namespace_label_team="payments"} 1
+			`,
+		},
+	}
+
+	for i, c := range cases {
+		if err := c.update(); err != nil {
+			t.Fatalf("unexpected error updating stores in %vth run: %v", i, err)
+		}
+		if err := compareOutput(c.want, scrape()); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
+
+func TestValidatePodLabelEnrichment(t *testing.T) {
+	tests := []struct {
+		desc              string
+		enabledCollectors []string
+		nodeLabels        []string
+		namespaceLabels   []string
+		enrichmentMetrics []string
+		wantErr           bool
+	}{
+		{
+			desc:              "valid labels and metrics",
+			nodeLabels:        []string{"topology.kubernetes.io/zone"},
+			namespaceLabels:   []string{"team"},
+			enrichmentMetrics: []string{"kube_pod_info", "kube_pod_status_phase"},
+		},
+		{
+			desc:              "same label of nodes and namespaces",
+			nodeLabels:        []string{"team"},
+			namespaceLabels:   []string{"team"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+		},
+		{
+			desc:              "duplicate node label",
+			nodeLabels:        []string{"team", "team"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+			wantErr:           true,
+		},
+		{
+			desc:              "namespace labels sanitized to the same name",
+			namespaceLabels:   []string{"a.b", "a_b"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+			wantErr:           true,
+		},
+		{
+			desc:              "unknown metric",
+			nodeLabels:        []string{"team"},
+			enrichmentMetrics: []string{"kube_pod_inof"},
+			wantErr:           true,
+		},
+		{
+			desc:              "metric of another collector",
+			nodeLabels:        []string{"team"},
+			enrichmentMetrics: []string{"kube_node_info"},
+			wantErr:           true,
+		},
+		{
+			desc:              "node labels without nodes collector",
+			enabledCollectors: []string{"namespaces", "pods"},
+			nodeLabels:        []string{"topology.kubernetes.io/zone"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+			wantErr:           true,
+		},
+		{
+			desc:              "namespace labels without namespaces collector",
+			enabledCollectors: []string{"nodes", "pods"},
+			namespaceLabels:   []string{"team"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+			wantErr:           true,
+		},
+		{
+			desc:              "no labels without nodes and namespaces collectors",
+			enabledCollectors: []string{"pods"},
+			enrichmentMetrics: []string{"kube_pod_info"},
+		},
+	}
+
+	for _, test := range tests {
+		enabledCollectors := test.enabledCollectors
+		if enabledCollectors == nil {
+			enabledCollectors = []string{"namespaces", "nodes", "pods"}
+		}
+		err := ValidatePodLabelEnrichment(enabledCollectors, test.nodeLabels, test.namespaceLabels, test.enrichmentMetrics)
+		if test.wantErr && err == nil {
+			t.Errorf("%s: expected an error, got none", test.desc)
+		}
+		if !test.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.desc, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descPriorityClassLabelsName          = "kube_priorityclass_labels"
+	descPriorityClassLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descPriorityClassLabelsDefaultLabels = []string{"priorityclass"}
+
+	priorityClassMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_priorityclass_info",
+			Type: metric.Gauge,
+			Help: "Information about priorityclass.",
+			GenerateFunc: wrapPriorityClassFunc(func(p *schedulingv1beta1.PriorityClass) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"description"},
+							LabelValues: []string{p.Description},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_priorityclass_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapPriorityClassFunc(func(p *schedulingv1beta1.PriorityClass) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !p.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(p.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descPriorityClassLabelsName,
+			Type: metric.Gauge,
+			Help: descPriorityClassLabelsHelp,
+			GenerateFunc: wrapPriorityClassFunc(func(p *schedulingv1beta1.PriorityClass) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(p.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_priorityclass_value",
+			Type: metric.Gauge,
+			Help: "Priority value pods using the priorityclass receive.",
+			GenerateFunc: wrapPriorityClassFunc(func(p *schedulingv1beta1.PriorityClass) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: float64(p.Value),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_priorityclass_global_default",
+			Type: metric.Gauge,
+			Help: "Whether the priorityclass is the default for pods without a priority class name.",
+			GenerateFunc: wrapPriorityClassFunc(func(p *schedulingv1beta1.PriorityClass) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: boolFloat64(p.GlobalDefault),
+						},
+					},
+				}
+			}),
+		},
+	}
+)
+
+func wrapPriorityClassFunc(f func(*schedulingv1beta1.PriorityClass) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		priorityClass := obj.(*schedulingv1beta1.PriorityClass)
+
+		metricFamily := f(priorityClass)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descPriorityClassLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{priorityClass.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createPriorityClassListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.SchedulingV1beta1().PriorityClasses().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.SchedulingV1beta1().PriorityClasses().Watch(opts)
+		},
+	}
+}
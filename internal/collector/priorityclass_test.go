@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestPriorityClassCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_priorityclass_info Information about priorityclass.
+		# TYPE kube_priorityclass_info gauge
+		# HELP kube_priorityclass_created Unix creation timestamp
+		# TYPE kube_priorityclass_created gauge
+		# HELP kube_priorityclass_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_priorityclass_labels gauge
+		# HELP kube_priorityclass_value Priority value pods using the priorityclass receive.
+		# TYPE kube_priorityclass_value gauge
+		# HELP kube_priorityclass_global_default Whether the priorityclass is the default for pods without a priority class name.
+		# TYPE kube_priorityclass_global_default gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &schedulingv1beta1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "system-cluster-critical",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Value:       2000000000,
+				Description: "Used for system critical pods that must run in the cluster.",
+			},
+			Want: `
+				kube_priorityclass_info{description="Used for system critical pods that must run in the cluster.",priorityclass="system-cluster-critical"} 1
+				kube_priorityclass_created{priorityclass="system-cluster-critical"} 1.5e+09
+				kube_priorityclass_labels{priorityclass="system-cluster-critical"} 1
+				kube_priorityclass_value{priorityclass="system-cluster-critical"} 2e+09
+				kube_priorityclass_global_default{priorityclass="system-cluster-critical"} 0
+`,
+		},
+		{
+			Obj: &schedulingv1beta1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "batch",
+					Labels: map[string]string{
+						"tier": "low",
+					},
+				},
+				Value:         -10,
+				GlobalDefault: true,
+			},
+			Want: `
+				kube_priorityclass_labels{label_tier="low",priorityclass="batch"} 1
+				kube_priorityclass_value{priorityclass="batch"} -10
+				kube_priorityclass_global_default{priorityclass="batch"} 1
+`,
+			MetricNames: []string{
+				"kube_priorityclass_labels",
+				"kube_priorityclass_value",
+				"kube_priorityclass_global_default",
+			},
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(priorityClassMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package collector
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/kube-state-metrics/pkg/metric"
 
@@ -74,6 +76,76 @@ var (
 					m.LabelKeys = []string{"resource", "type"}
 				}
 
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_resourcequota_usage_ratio",
+			Type: metric.Gauge,
+			Help: "Ratio of used to hard quota for each resource of the resource quota.",
+			GenerateFunc: wrapResourceQuotaFunc(func(r *v1.ResourceQuota) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for res, hard := range r.Status.Hard {
+					used, ok := r.Status.Used[res]
+					if !ok || hard.IsZero() {
+						continue
+					}
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"resource"},
+						LabelValues: []string{string(res)},
+						Value:       quantityFloat64(used) / quantityFloat64(hard),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_resourcequota_scope",
+			Type: metric.Gauge,
+			Help: "Scope the resource quota is restricted to.",
+			GenerateFunc: wrapResourceQuotaFunc(func(r *v1.ResourceQuota) *metric.Family {
+				ms := make([]*metric.Metric, len(r.Spec.Scopes))
+
+				for i, scope := range r.Spec.Scopes {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"scope"},
+						LabelValues: []string{string(scope)},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_resourcequota_scope_selector",
+			Type: metric.Gauge,
+			Help: "Scope selector expression the resource quota is restricted to.",
+			GenerateFunc: wrapResourceQuotaFunc(func(r *v1.ResourceQuota) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if r.Spec.ScopeSelector == nil {
+					return &metric.Family{
+						Metrics: ms,
+					}
+				}
+
+				for _, expr := range r.Spec.ScopeSelector.MatchExpressions {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"scope", "operator", "values"},
+						LabelValues: []string{string(expr.ScopeName), string(expr.Operator), strings.Join(expr.Values, ",")},
+						Value:       1,
+					})
+				}
+
 				return &metric.Family{
 					Metrics: ms,
 				}
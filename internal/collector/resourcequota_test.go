@@ -34,6 +34,12 @@ func TestResourceQuotaCollector(t *testing.T) {
 	# TYPE kube_resourcequota gauge
 	# HELP kube_resourcequota_created Unix creation timestamp
 	# TYPE kube_resourcequota_created gauge
+	# HELP kube_resourcequota_usage_ratio Ratio of used to hard quota for each resource of the resource quota.
+	# TYPE kube_resourcequota_usage_ratio gauge
+	# HELP kube_resourcequota_scope Scope the resource quota is restricted to.
+	# TYPE kube_resourcequota_scope gauge
+	# HELP kube_resourcequota_scope_selector Scope selector expression the resource quota is restricted to.
+	# TYPE kube_resourcequota_scope_selector gauge
 	`
 	cases := []generateMetricsTestCase{
 		// Verify populating base metric and that metric for unset fields are skipped.
@@ -129,8 +135,87 @@ func TestResourceQuotaCollector(t *testing.T) {
 			kube_resourcequota{namespace="testNS",resource="services.nodeports",resourcequota="quotaTest",type="used"} 1
 			kube_resourcequota{namespace="testNS",resource="storage",resourcequota="quotaTest",type="hard"} 1e+10
 			kube_resourcequota{namespace="testNS",resource="storage",resourcequota="quotaTest",type="used"} 9e+09
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="configmaps",resourcequota="quotaTest"} 0.75
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="cpu",resourcequota="quotaTest"} 0.48837209302325585
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="memory",resourcequota="quotaTest"} 0.23809523809523808
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="persistentvolumeclaims",resourcequota="quotaTest"} 0.6666666666666666
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="pods",resourcequota="quotaTest"} 0.8888888888888888
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="replicationcontrollers",resourcequota="quotaTest"} 0.8571428571428571
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="resourcequotas",resourcequota="quotaTest"} 0.8333333333333334
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="secrets",resourcequota="quotaTest"} 0.8
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="services",resourcequota="quotaTest"} 0.875
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="services.loadbalancers",resourcequota="quotaTest"} 0
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="services.nodeports",resourcequota="quotaTest"} 0.5
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="storage",resourcequota="quotaTest"} 0.9
 			`,
 		},
+		// Verify scope and scope selector metrics.
+		{
+			Obj: &v1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quotaTest",
+					Namespace: "testNS",
+				},
+				Spec: v1.ResourceQuotaSpec{
+					Scopes: []v1.ResourceQuotaScope{
+						v1.ResourceQuotaScopeBestEffort,
+						v1.ResourceQuotaScopeNotTerminating,
+					},
+					ScopeSelector: &v1.ScopeSelector{
+						MatchExpressions: []v1.ScopedResourceSelectorRequirement{
+							{
+								ScopeName: v1.ResourceQuotaScopePriorityClass,
+								Operator:  v1.ScopeSelectorOpIn,
+								Values:    []string{"high", "medium"},
+							},
+						},
+					},
+				},
+				Status: v1.ResourceQuotaStatus{
+					Hard: v1.ResourceList{
+						v1.ResourcePods:    resource.MustParse("10"),
+						v1.ResourceSecrets: resource.MustParse("0"),
+					},
+					Used: v1.ResourceList{
+						v1.ResourcePods:    resource.MustParse("4"),
+						v1.ResourceSecrets: resource.MustParse("0"),
+					},
+				},
+			},
+			Want: `
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="pods",resourcequota="quotaTest"} 0.4
+			kube_resourcequota_scope{namespace="testNS",resourcequota="quotaTest",scope="BestEffort"} 1
+			kube_resourcequota_scope{namespace="testNS",resourcequota="quotaTest",scope="NotTerminating"} 1
+			kube_resourcequota_scope_selector{namespace="testNS",operator="In",resourcequota="quotaTest",scope="PriorityClass",values="high,medium"} 1
+			`,
+			MetricNames: []string{
+				"kube_resourcequota_usage_ratio",
+				"kube_resourcequota_scope",
+			},
+		},
+		// Verify the usage ratio of quantities that overflow in milli units.
+		{
+			Obj: &v1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quotaTest",
+					Namespace: "testNS",
+				},
+				Status: v1.ResourceQuotaStatus{
+					Hard: v1.ResourceList{
+						v1.ResourceRequestsStorage: resource.MustParse("20E"),
+					},
+					Used: v1.ResourceList{
+						v1.ResourceRequestsStorage: resource.MustParse("5E"),
+					},
+				},
+			},
+			Want: `
+			kube_resourcequota_usage_ratio{namespace="testNS",resource="requests.storage",resourcequota="quotaTest"} 0.25
+			`,
+			MetricNames: []string{
+				"kube_resourcequota_usage_ratio",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(resourceQuotaMetricFamilies)
@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descRoleBindingLabelsName          = "kube_rolebinding_labels"
+	descRoleBindingLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descRoleBindingLabelsDefaultLabels = []string{"namespace", "rolebinding"}
+
+	roleBindingMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_rolebinding_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapRoleBindingFunc(func(b *rbacv1.RoleBinding) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !b.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(b.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descRoleBindingLabelsName,
+			Type: metric.Gauge,
+			Help: descRoleBindingLabelsHelp,
+			GenerateFunc: wrapRoleBindingFunc(func(b *rbacv1.RoleBinding) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(b.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_rolebinding_info",
+			Type: metric.Gauge,
+			Help: "Information about the role referenced by the rolebinding.",
+			GenerateFunc: wrapRoleBindingFunc(func(b *rbacv1.RoleBinding) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						roleRefMetric(b.RoleRef),
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_rolebinding_subject",
+			Type: metric.Gauge,
+			Help: "Subject the rolebinding grants the referenced role to.",
+			GenerateFunc: wrapRoleBindingFunc(func(b *rbacv1.RoleBinding) *metric.Family {
+				return &metric.Family{
+					Metrics: subjectMetrics(b.Subjects),
+				}
+			}),
+		},
+	}
+)
+
+// roleRefMetric returns an info metric describing the role a binding
+// references.
+func roleRefMetric(ref rbacv1.RoleRef) *metric.Metric {
+	return &metric.Metric{
+		LabelKeys:   []string{"role_kind", "role_name"},
+		LabelValues: []string{ref.Kind, ref.Name},
+		Value:       1,
+	}
+}
+
+// subjectMetrics returns one metric per distinct binding subject.
+func subjectMetrics(subjects []rbacv1.Subject) []*metric.Metric {
+	ms := []*metric.Metric{}
+	seen := map[rbacv1.Subject]struct{}{}
+
+	for _, s := range subjects {
+		key := rbacv1.Subject{Kind: s.Kind, Name: s.Name, Namespace: s.Namespace}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		ms = append(ms, &metric.Metric{
+			LabelKeys:   []string{"subject_kind", "subject_name", "subject_namespace"},
+			LabelValues: []string{s.Kind, s.Name, s.Namespace},
+			Value:       1,
+		})
+	}
+
+	return ms
+}
+
+func wrapRoleBindingFunc(f func(*rbacv1.RoleBinding) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		roleBinding := obj.(*rbacv1.RoleBinding)
+
+		metricFamily := f(roleBinding)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descRoleBindingLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{roleBinding.Namespace, roleBinding.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createRoleBindingListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.RbacV1().RoleBindings(ns).List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.RbacV1().RoleBindings(ns).Watch(opts)
+		},
+	}
+}
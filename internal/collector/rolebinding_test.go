@@ -0,0 +1,87 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+func TestRoleBindingCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_rolebinding_created Unix creation timestamp
+		# TYPE kube_rolebinding_created gauge
+		# HELP kube_rolebinding_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_rolebinding_labels gauge
+		# HELP kube_rolebinding_info Information about the role referenced by the rolebinding.
+		# TYPE kube_rolebinding_info gauge
+		# HELP kube_rolebinding_subject Subject the rolebinding grants the referenced role to.
+		# TYPE kube_rolebinding_subject gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "readers",
+					Namespace:         "ns1",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "Role",
+					Name:     "reader",
+				},
+				Subjects: []rbacv1.Subject{
+					{
+						Kind:     rbacv1.UserKind,
+						APIGroup: rbacv1.GroupName,
+						Name:     "jane",
+					},
+					{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      "builder",
+						Namespace: "ci",
+					},
+					{
+						Kind:      rbacv1.ServiceAccountKind,
+						Name:      "builder",
+						Namespace: "ci",
+					},
+				},
+			},
+			Want: `
+				kube_rolebinding_created{namespace="ns1",rolebinding="readers"} 1.5e+09
+				kube_rolebinding_labels{namespace="ns1",rolebinding="readers"} 1
+				kube_rolebinding_info{namespace="ns1",role_kind="Role",role_name="reader",rolebinding="readers"} 1
+				kube_rolebinding_subject{namespace="ns1",rolebinding="readers",subject_kind="ServiceAccount",subject_name="builder",subject_namespace="ci"} 1
+				kube_rolebinding_subject{namespace="ns1",rolebinding="readers",subject_kind="User",subject_name="jane",subject_namespace=""} 1
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(roleBindingMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
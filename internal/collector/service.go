@@ -17,6 +17,8 @@ limitations under the License.
 package collector
 
 import (
+	"strconv"
+
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/kube-state-metrics/pkg/metric"
 
@@ -135,6 +137,124 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_service_spec_port",
+			Type: metric.Gauge,
+			Help: "Service ports. One series for each port",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				ms := make([]*metric.Metric, len(s.Spec.Ports))
+
+				for i, p := range s.Spec.Ports {
+					nodePort := ""
+					if p.NodePort != 0 {
+						nodePort = strconv.FormatInt(int64(p.NodePort), 10)
+					}
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"port_name", "port_protocol", "port_number", "target_port", "node_port"},
+						LabelValues: []string{p.Name, string(p.Protocol), strconv.FormatInt(int64(p.Port), 10), p.TargetPort.String(), nodePort},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_service_spec_selector",
+			Type: metric.Gauge,
+			Help: "Service selector converted to Prometheus labels.",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				if len(s.Spec.Selector) == 0 {
+					return &metric.Family{Metrics: []*metric.Metric{}}
+				}
+				selectorKeys, selectorValues := mapToPrometheusLabels(s.Spec.Selector, "selector")
+				m := metric.Metric{
+					LabelKeys:   selectorKeys,
+					LabelValues: selectorValues,
+					Value:       1,
+				}
+				return &metric.Family{Metrics: []*metric.Metric{&m}}
+			}),
+		},
+		{
+			Name: "kube_service_spec_session_affinity",
+			Type: metric.Gauge,
+			Help: "Session affinity of the service.",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				if s.Spec.SessionAffinity == "" {
+					return &metric.Family{Metrics: []*metric.Metric{}}
+				}
+				m := metric.Metric{
+					LabelKeys:   []string{"session_affinity"},
+					LabelValues: []string{string(s.Spec.SessionAffinity)},
+					Value:       1,
+				}
+				return &metric.Family{Metrics: []*metric.Metric{&m}}
+			}),
+		},
+		{
+			Name: "kube_service_spec_external_traffic_policy",
+			Type: metric.Gauge,
+			Help: "External traffic policy of the service.",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				if s.Spec.ExternalTrafficPolicy == "" {
+					return &metric.Family{Metrics: []*metric.Metric{}}
+				}
+				m := metric.Metric{
+					LabelKeys:   []string{"external_traffic_policy"},
+					LabelValues: []string{string(s.Spec.ExternalTrafficPolicy)},
+					Value:       1,
+				}
+				return &metric.Family{Metrics: []*metric.Metric{&m}}
+			}),
+		},
+		{
+			Name: "kube_service_spec_health_check_node_port",
+			Type: metric.Gauge,
+			Help: "Health check node port of the service.",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				if s.Spec.HealthCheckNodePort == 0 {
+					return &metric.Family{Metrics: []*metric.Metric{}}
+				}
+				m := metric.Metric{
+					Value: float64(s.Spec.HealthCheckNodePort),
+				}
+				return &metric.Family{Metrics: []*metric.Metric{&m}}
+			}),
+		},
+		{
+			Name: "kube_service_spec_load_balancer_source_range",
+			Type: metric.Gauge,
+			Help: "Service load balancer source ranges. One series for each range",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				ms := make([]*metric.Metric, len(s.Spec.LoadBalancerSourceRanges))
+
+				for i, sourceRange := range s.Spec.LoadBalancerSourceRanges {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"source_range"},
+						LabelValues: []string{sourceRange},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_service_spec_publish_not_ready_addresses",
+			Type: metric.Gauge,
+			Help: "Whether endpoints of the service are published regardless of their readiness.",
+			GenerateFunc: wrapSvcFunc(func(s *v1.Service) *metric.Family {
+				m := metric.Metric{
+					Value: boolFloat64(s.Spec.PublishNotReadyAddresses),
+				}
+				return &metric.Family{Metrics: []*metric.Metric{&m}}
+			}),
+		},
 	}
 )
 
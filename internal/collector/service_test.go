@@ -22,6 +22,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kube-state-metrics/pkg/metric"
 )
 
@@ -41,6 +42,20 @@ func TestServiceCollector(t *testing.T) {
 		# TYPE kube_service_spec_external_ip gauge
 		# HELP kube_service_status_load_balancer_ingress Service load balancer ingress status
 		# TYPE kube_service_status_load_balancer_ingress gauge
+		# HELP kube_service_spec_port Service ports. One series for each port
+		# TYPE kube_service_spec_port gauge
+		# HELP kube_service_spec_selector Service selector converted to Prometheus labels.
+		# TYPE kube_service_spec_selector gauge
+		# HELP kube_service_spec_session_affinity Session affinity of the service.
+		# TYPE kube_service_spec_session_affinity gauge
+		# HELP kube_service_spec_external_traffic_policy External traffic policy of the service.
+		# TYPE kube_service_spec_external_traffic_policy gauge
+		# HELP kube_service_spec_health_check_node_port Health check node port of the service.
+		# TYPE kube_service_spec_health_check_node_port gauge
+		# HELP kube_service_spec_load_balancer_source_range Service load balancer source ranges. One series for each range
+		# TYPE kube_service_spec_load_balancer_source_range gauge
+		# HELP kube_service_spec_publish_not_ready_addresses Whether endpoints of the service are published regardless of their readiness.
+		# TYPE kube_service_spec_publish_not_ready_addresses gauge
 	`
 	cases := []generateMetricsTestCase{
 		{
@@ -92,6 +107,7 @@ func TestServiceCollector(t *testing.T) {
 				kube_service_info{cluster_ip="1.2.3.5",external_name="",load_balancer_ip="",namespace="default",service="test-service2"} 1
 				kube_service_labels{label_app="example2",namespace="default",service="test-service2"} 1
 				kube_service_spec_type{namespace="default",service="test-service2",type="NodePort"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service2"} 0
 `,
 		},
 		{
@@ -115,6 +131,7 @@ func TestServiceCollector(t *testing.T) {
 				kube_service_info{cluster_ip="1.2.3.6",external_name="",load_balancer_ip="1.2.3.7",namespace="default",service="test-service3"} 1
 				kube_service_labels{label_app="example3",namespace="default",service="test-service3"} 1
 				kube_service_spec_type{namespace="default",service="test-service3",type="LoadBalancer"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service3"} 0
 `,
 		},
 		{
@@ -137,6 +154,7 @@ func TestServiceCollector(t *testing.T) {
 				kube_service_info{cluster_ip="",external_name="www.example.com",load_balancer_ip="",namespace="default",service="test-service4"} 1
 				kube_service_labels{label_app="example4",namespace="default",service="test-service4"} 1
 				kube_service_spec_type{namespace="default",service="test-service4",type="ExternalName"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service4"} 0
 			`,
 		},
 		{
@@ -168,6 +186,7 @@ func TestServiceCollector(t *testing.T) {
 				kube_service_info{cluster_ip="",external_name="",load_balancer_ip="",namespace="default",service="test-service5"} 1
 				kube_service_labels{label_app="example5",namespace="default",service="test-service5"} 1
 				kube_service_spec_type{namespace="default",service="test-service5",type="LoadBalancer"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service5"} 0
 				kube_service_status_load_balancer_ingress{hostname="www.example.com",ip="1.2.3.8",namespace="default",service="test-service5"} 1
 			`,
 		},
@@ -194,10 +213,66 @@ func TestServiceCollector(t *testing.T) {
 				kube_service_info{cluster_ip="",external_name="",load_balancer_ip="",namespace="default",service="test-service6"} 1
 				kube_service_labels{label_app="example6",namespace="default",service="test-service6"} 1
 				kube_service_spec_type{namespace="default",service="test-service6",type="ClusterIP"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service6"} 0
 				kube_service_spec_external_ip{external_ip="1.2.3.9",namespace="default",service="test-service6"} 1
 				kube_service_spec_external_ip{external_ip="1.2.3.10",namespace="default",service="test-service6"} 1
 			`,
 		},
+		{
+			Obj: &v1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "test-service7",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+					Namespace:         "default",
+				},
+				Spec: v1.ServiceSpec{
+					Type: v1.ServiceTypeLoadBalancer,
+					Ports: []v1.ServicePort{
+						{
+							Name:       "http",
+							Protocol:   v1.ProtocolTCP,
+							Port:       80,
+							TargetPort: intstr.FromString("web"),
+							NodePort:   30080,
+						},
+						{
+							Protocol:   v1.ProtocolUDP,
+							Port:       53,
+							TargetPort: intstr.FromInt(5353),
+						},
+					},
+					Selector: map[string]string{
+						"app":                    "example7",
+						"app.kubernetes.io/name": "example",
+					},
+					SessionAffinity:          v1.ServiceAffinityClientIP,
+					ExternalTrafficPolicy:    v1.ServiceExternalTrafficPolicyTypeLocal,
+					HealthCheckNodePort:      31000,
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+					PublishNotReadyAddresses: true,
+				},
+			},
+			Want: `
+				kube_service_spec_port{namespace="default",node_port="30080",port_name="http",port_number="80",port_protocol="TCP",service="test-service7",target_port="web"} 1
+				kube_service_spec_port{namespace="default",node_port="",port_name="",port_number="53",port_protocol="UDP",service="test-service7",target_port="5353"} 1
+				kube_service_spec_selector{namespace="default",selector_app="example7",selector_app_kubernetes_io_name="example",service="test-service7"} 1
+				kube_service_spec_session_affinity{namespace="default",service="test-service7",session_affinity="ClientIP"} 1
+				kube_service_spec_external_traffic_policy{external_traffic_policy="Local",namespace="default",service="test-service7"} 1
+				kube_service_spec_health_check_node_port{namespace="default",service="test-service7"} 31000
+				kube_service_spec_load_balancer_source_range{namespace="default",service="test-service7",source_range="10.0.0.0/8"} 1
+				kube_service_spec_load_balancer_source_range{namespace="default",service="test-service7",source_range="192.168.0.0/16"} 1
+				kube_service_spec_publish_not_ready_addresses{namespace="default",service="test-service7"} 1
+			`,
+			MetricNames: []string{
+				"kube_service_spec_port",
+				"kube_service_spec_selector",
+				"kube_service_spec_session_affinity",
+				"kube_service_spec_external_traffic_policy",
+				"kube_service_spec_health_check_node_port",
+				"kube_service_spec_load_balancer_source_range",
+				"kube_service_spec_publish_not_ready_addresses",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(serviceMetricFamilies)
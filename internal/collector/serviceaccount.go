@@ -0,0 +1,155 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descServiceAccountLabelsName          = "kube_serviceaccount_labels"
+	descServiceAccountLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descServiceAccountLabelsDefaultLabels = []string{"namespace", "serviceaccount"}
+
+	serviceAccountMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_serviceaccount_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapServiceAccountFunc(func(sa *v1.ServiceAccount) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !sa.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(sa.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descServiceAccountLabelsName,
+			Type: metric.Gauge,
+			Help: descServiceAccountLabelsHelp,
+			GenerateFunc: wrapServiceAccountFunc(func(sa *v1.ServiceAccount) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(sa.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_serviceaccount_automount_token",
+			Type: metric.Gauge,
+			Help: "Whether pods using the serviceaccount get its API token mounted automatically.",
+			GenerateFunc: wrapServiceAccountFunc(func(sa *v1.ServiceAccount) *metric.Family {
+				// Tokens are mounted unless explicitly opted out of.
+				automount := sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: boolFloat64(automount),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_serviceaccount_secret",
+			Type: metric.Gauge,
+			Help: "Secret referenced by the serviceaccount.",
+			GenerateFunc: wrapServiceAccountFunc(func(sa *v1.ServiceAccount) *metric.Family {
+				ms := make([]*metric.Metric, len(sa.Secrets))
+
+				for i, s := range sa.Secrets {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"secret"},
+						LabelValues: []string{s.Name},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_serviceaccount_image_pull_secret",
+			Type: metric.Gauge,
+			Help: "Image pull secret referenced by the serviceaccount.",
+			GenerateFunc: wrapServiceAccountFunc(func(sa *v1.ServiceAccount) *metric.Family {
+				ms := make([]*metric.Metric, len(sa.ImagePullSecrets))
+
+				for i, s := range sa.ImagePullSecrets {
+					ms[i] = &metric.Metric{
+						LabelKeys:   []string{"secret"},
+						LabelValues: []string{s.Name},
+						Value:       1,
+					}
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+	}
+)
+
+func wrapServiceAccountFunc(f func(*v1.ServiceAccount) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		serviceAccount := obj.(*v1.ServiceAccount)
+
+		metricFamily := f(serviceAccount)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descServiceAccountLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{serviceAccount.Namespace, serviceAccount.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createServiceAccountListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.CoreV1().ServiceAccounts(ns).List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.CoreV1().ServiceAccounts(ns).Watch(opts)
+		},
+	}
+}
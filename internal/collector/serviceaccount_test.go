@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+var (
+	serviceAccount2Automount = false
+)
+
+func TestServiceAccountCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_serviceaccount_created Unix creation timestamp
+		# TYPE kube_serviceaccount_created gauge
+		# HELP kube_serviceaccount_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_serviceaccount_labels gauge
+		# HELP kube_serviceaccount_automount_token Whether pods using the serviceaccount get its API token mounted automatically.
+		# TYPE kube_serviceaccount_automount_token gauge
+		# HELP kube_serviceaccount_secret Secret referenced by the serviceaccount.
+		# TYPE kube_serviceaccount_secret gauge
+		# HELP kube_serviceaccount_image_pull_secret Image pull secret referenced by the serviceaccount.
+		# TYPE kube_serviceaccount_image_pull_secret gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &v1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "default",
+					Namespace:         "ns1",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Secrets: []v1.ObjectReference{
+					{Name: "default-token-abcde"},
+				},
+			},
+			Want: `
+				kube_serviceaccount_created{namespace="ns1",serviceaccount="default"} 1.5e+09
+				kube_serviceaccount_labels{namespace="ns1",serviceaccount="default"} 1
+				kube_serviceaccount_automount_token{namespace="ns1",serviceaccount="default"} 1
+				kube_serviceaccount_secret{namespace="ns1",secret="default-token-abcde",serviceaccount="default"} 1
+`,
+		},
+		{
+			Obj: &v1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "builder",
+					Namespace: "ci",
+					Labels: map[string]string{
+						"app": "ci",
+					},
+				},
+				AutomountServiceAccountToken: &serviceAccount2Automount,
+				Secrets: []v1.ObjectReference{
+					{Name: "builder-token-fghij"},
+					{Name: "builder-ssh-key"},
+				},
+				ImagePullSecrets: []v1.LocalObjectReference{
+					{Name: "registry-credentials"},
+				},
+			},
+			Want: `
+				kube_serviceaccount_labels{label_app="ci",namespace="ci",serviceaccount="builder"} 1
+				kube_serviceaccount_automount_token{namespace="ci",serviceaccount="builder"} 0
+				kube_serviceaccount_secret{namespace="ci",secret="builder-ssh-key",serviceaccount="builder"} 1
+				kube_serviceaccount_secret{namespace="ci",secret="builder-token-fghij",serviceaccount="builder"} 1
+				kube_serviceaccount_image_pull_secret{namespace="ci",secret="registry-credentials",serviceaccount="builder"} 1
+`,
+			MetricNames: []string{
+				"kube_serviceaccount_labels",
+				"kube_serviceaccount_automount_token",
+				"kube_serviceaccount_secret",
+				"kube_serviceaccount_image_pull_secret",
+			},
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(serviceAccountMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"k8s.io/kube-state-metrics/pkg/metric"
 
 	"k8s.io/api/apps/v1beta1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
@@ -206,6 +207,109 @@ var (
 				}
 			}),
 		},
+		{
+			Name: "kube_statefulset_spec_update_strategy",
+			Type: metric.Gauge,
+			Help: "The update strategy of the StatefulSet.",
+			GenerateFunc: wrapStatefulSetFunc(func(s *v1beta1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if s.Spec.UpdateStrategy.Type != "" {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"type"},
+						LabelValues: []string{string(s.Spec.UpdateStrategy.Type)},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_statefulset_spec_update_strategy_partition",
+			Type: metric.Gauge,
+			Help: "The ordinal at which the StatefulSet is partitioned for rolling updates.",
+			GenerateFunc: wrapStatefulSetFunc(func(s *v1beta1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if ru := s.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+					ms = append(ms, &metric.Metric{
+						Value: float64(*ru.Partition),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_statefulset_spec_pod_management_policy",
+			Type: metric.Gauge,
+			Help: "The pod management policy of the StatefulSet.",
+			GenerateFunc: wrapStatefulSetFunc(func(s *v1beta1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if s.Spec.PodManagementPolicy != "" {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"policy"},
+						LabelValues: []string{string(s.Spec.PodManagementPolicy)},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_statefulset_spec_service_name",
+			Type: metric.Gauge,
+			Help: "The name of the service governing the StatefulSet.",
+			GenerateFunc: wrapStatefulSetFunc(func(s *v1beta1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if s.Spec.ServiceName != "" {
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"service_name"},
+						LabelValues: []string{s.Spec.ServiceName},
+						Value:       1,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_statefulset_spec_volume_claim_template_storage_bytes",
+			Type: metric.Gauge,
+			Help: "The storage requested by each volume claim template of the StatefulSet.",
+			GenerateFunc: wrapStatefulSetFunc(func(s *v1beta1.StatefulSet) *metric.Family {
+				ms := []*metric.Metric{}
+
+				for i := range s.Spec.VolumeClaimTemplates {
+					t := &s.Spec.VolumeClaimTemplates[i]
+					var value float64
+					if storage, ok := t.Spec.Resources.Requests[v1.ResourceStorage]; ok {
+						value = float64(storage.Value())
+					}
+					ms = append(ms, &metric.Metric{
+						LabelKeys:   []string{"volume_claim_template", "storageclass"},
+						LabelValues: []string{t.Name, getPersistentVolumeClaimClass(t)},
+						Value:       value,
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
 	}
 )
 
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"k8s.io/api/apps/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kube-state-metrics/pkg/metric"
 )
@@ -32,6 +34,9 @@ var (
 
 	statefulSet1ObservedGeneration int64 = 1
 	statefulSet2ObservedGeneration int64 = 2
+
+	statefulSet4Partition    int32 = 2
+	statefulSet4StorageClass       = "ssd"
 )
 
 func TestStatefuleSetCollector(t *testing.T) {
@@ -60,6 +65,16 @@ func TestStatefuleSetCollector(t *testing.T) {
  		# TYPE kube_statefulset_metadata_generation gauge
 		# HELP kube_statefulset_labels Kubernetes labels converted to Prometheus labels.
 		# TYPE kube_statefulset_labels gauge
+		# HELP kube_statefulset_spec_update_strategy The update strategy of the StatefulSet.
+		# TYPE kube_statefulset_spec_update_strategy gauge
+		# HELP kube_statefulset_spec_update_strategy_partition The ordinal at which the StatefulSet is partitioned for rolling updates.
+		# TYPE kube_statefulset_spec_update_strategy_partition gauge
+		# HELP kube_statefulset_spec_pod_management_policy The pod management policy of the StatefulSet.
+		# TYPE kube_statefulset_spec_pod_management_policy gauge
+		# HELP kube_statefulset_spec_service_name The name of the service governing the StatefulSet.
+		# TYPE kube_statefulset_spec_service_name gauge
+		# HELP kube_statefulset_spec_volume_claim_template_storage_bytes The storage requested by each volume claim template of the StatefulSet.
+		# TYPE kube_statefulset_spec_volume_claim_template_storage_bytes gauge
  	`
 	cases := []generateMetricsTestCase{
 		{
@@ -204,6 +219,58 @@ func TestStatefuleSetCollector(t *testing.T) {
 				"kube_statefulset_status_current_revision",
 			},
 		},
+		{
+			Obj: &v1beta1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "statefulset4",
+					Namespace: "ns4",
+				},
+				Spec: v1beta1.StatefulSetSpec{
+					ServiceName:         "statefulset4service",
+					PodManagementPolicy: v1beta1.ParallelPodManagement,
+					UpdateStrategy: v1beta1.StatefulSetUpdateStrategy{
+						Type: v1beta1.RollingUpdateStatefulSetStrategyType,
+						RollingUpdate: &v1beta1.RollingUpdateStatefulSetStrategy{
+							Partition: &statefulSet4Partition,
+						},
+					},
+					VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "data",
+							},
+							Spec: v1.PersistentVolumeClaimSpec{
+								StorageClassName: &statefulSet4StorageClass,
+								Resources: v1.ResourceRequirements{
+									Requests: v1.ResourceList{
+										v1.ResourceStorage: resource.MustParse("10Gi"),
+									},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "logs",
+							},
+						},
+					},
+				},
+			},
+			Want: `
+				kube_statefulset_spec_update_strategy{namespace="ns4",statefulset="statefulset4",type="RollingUpdate"} 1
+				kube_statefulset_spec_update_strategy_partition{namespace="ns4",statefulset="statefulset4"} 2
+				kube_statefulset_spec_pod_management_policy{namespace="ns4",policy="Parallel",statefulset="statefulset4"} 1
+				kube_statefulset_spec_service_name{namespace="ns4",service_name="statefulset4service",statefulset="statefulset4"} 1
+				kube_statefulset_spec_volume_claim_template_storage_bytes{namespace="ns4",statefulset="statefulset4",storageclass="ssd",volume_claim_template="data"} 1.073741824e+10
+				kube_statefulset_spec_volume_claim_template_storage_bytes{namespace="ns4",statefulset="statefulset4",storageclass="<none>",volume_claim_template="logs"} 0
+ 			`,
+			MetricNames: []string{
+				"kube_statefulset_spec_update_strategy",
+				"kube_statefulset_spec_pod_management_policy",
+				"kube_statefulset_spec_service_name",
+				"kube_statefulset_spec_volume_claim_template_storage_bytes",
+			},
+		},
 	}
 	for i, c := range cases {
 		c.Func = metric.ComposeMetricGenFuncs(statefulSetMetricFamilies)
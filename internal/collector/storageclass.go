@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descStorageClassLabelsName          = "kube_storageclass_labels"
+	descStorageClassLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descStorageClassLabelsDefaultLabels = []string{"storageclass"}
+
+	// storageClassDefaultAnnotations are the annotations marking a storage
+	// class as the cluster default, in order of precedence.
+	storageClassDefaultAnnotations = []string{
+		"storageclass.kubernetes.io/is-default-class",
+		"storageclass.beta.kubernetes.io/is-default-class",
+	}
+
+	storageClassMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_storageclass_info",
+			Type: metric.Gauge,
+			Help: "Information about storageclass.",
+			GenerateFunc: wrapStorageClassFunc(func(s *storagev1.StorageClass) *metric.Family {
+				// Apply the same defaults as the API server does for unset fields.
+				reclaimPolicy := v1.PersistentVolumeReclaimDelete
+				if s.ReclaimPolicy != nil {
+					reclaimPolicy = *s.ReclaimPolicy
+				}
+				volumeBindingMode := storagev1.VolumeBindingImmediate
+				if s.VolumeBindingMode != nil {
+					volumeBindingMode = *s.VolumeBindingMode
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"provisioner", "reclaim_policy", "volume_binding_mode"},
+							LabelValues: []string{s.Provisioner, string(reclaimPolicy), string(volumeBindingMode)},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_storageclass_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapStorageClassFunc(func(s *storagev1.StorageClass) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !s.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(s.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_storageclass_allow_volume_expansion",
+			Type: metric.Gauge,
+			Help: "Whether the storageclass allows volume expansion.",
+			GenerateFunc: wrapStorageClassFunc(func(s *storagev1.StorageClass) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: boolFloat64(s.AllowVolumeExpansion != nil && *s.AllowVolumeExpansion),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_storageclass_default_class",
+			Type: metric.Gauge,
+			Help: "Whether the storageclass is marked as the default storageclass of the cluster.",
+			GenerateFunc: wrapStorageClassFunc(func(s *storagev1.StorageClass) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: boolFloat64(isDefaultStorageClass(s)),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: descStorageClassLabelsName,
+			Type: metric.Gauge,
+			Help: descStorageClassLabelsHelp,
+			GenerateFunc: wrapStorageClassFunc(func(s *storagev1.StorageClass) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(s.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+	}
+)
+
+// isDefaultStorageClass returns whether the storage class is annotated as the
+// cluster default, preferring the GA annotation over the beta one.
+func isDefaultStorageClass(s *storagev1.StorageClass) bool {
+	for _, a := range storageClassDefaultAnnotations {
+		if v, ok := s.Annotations[a]; ok {
+			return v == "true"
+		}
+	}
+	return false
+}
+
+func wrapStorageClassFunc(f func(*storagev1.StorageClass) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		storageClass := obj.(*storagev1.StorageClass)
+
+		metricFamily := f(storageClass)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descStorageClassLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{storageClass.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createStorageClassListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.StorageV1().StorageClasses().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.StorageV1().StorageClasses().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+var (
+	storageClass2ReclaimPolicy     = v1.PersistentVolumeReclaimRetain
+	storageClass2VolumeBindingMode = storagev1.VolumeBindingWaitForFirstConsumer
+	storageClass2AllowExpansion    = true
+)
+
+func TestStorageClassCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_storageclass_info Information about storageclass.
+		# TYPE kube_storageclass_info gauge
+		# HELP kube_storageclass_created Unix creation timestamp
+		# TYPE kube_storageclass_created gauge
+		# HELP kube_storageclass_allow_volume_expansion Whether the storageclass allows volume expansion.
+		# TYPE kube_storageclass_allow_volume_expansion gauge
+		# HELP kube_storageclass_default_class Whether the storageclass is marked as the default storageclass of the cluster.
+		# TYPE kube_storageclass_default_class gauge
+		# HELP kube_storageclass_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_storageclass_labels gauge
+	`
+	cases := []generateMetricsTestCase{
+		// Verify defaults are applied for unset fields.
+		{
+			Obj: &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "standard",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+					Annotations: map[string]string{
+						"storageclass.beta.kubernetes.io/is-default-class": "true",
+					},
+				},
+				Provisioner: "kubernetes.io/host-path",
+			},
+			Want: `
+				kube_storageclass_info{provisioner="kubernetes.io/host-path",reclaim_policy="Delete",storageclass="standard",volume_binding_mode="Immediate"} 1
+				kube_storageclass_created{storageclass="standard"} 1.5e+09
+				kube_storageclass_allow_volume_expansion{storageclass="standard"} 0
+				kube_storageclass_default_class{storageclass="standard"} 1
+				kube_storageclass_labels{storageclass="standard"} 1
+`,
+		},
+		{
+			Obj: &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fast",
+					Labels: map[string]string{
+						"tier": "ssd",
+					},
+					Annotations: map[string]string{
+						"storageclass.kubernetes.io/is-default-class":      "false",
+						"storageclass.beta.kubernetes.io/is-default-class": "true",
+					},
+				},
+				Provisioner:          "kubernetes.io/gce-pd",
+				ReclaimPolicy:        &storageClass2ReclaimPolicy,
+				VolumeBindingMode:    &storageClass2VolumeBindingMode,
+				AllowVolumeExpansion: &storageClass2AllowExpansion,
+			},
+			Want: `
+				kube_storageclass_info{provisioner="kubernetes.io/gce-pd",reclaim_policy="Retain",storageclass="fast",volume_binding_mode="WaitForFirstConsumer"} 1
+				kube_storageclass_allow_volume_expansion{storageclass="fast"} 1
+				kube_storageclass_default_class{storageclass="fast"} 0
+				kube_storageclass_labels{label_tier="ssd",storageclass="fast"} 1
+`,
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(storageClassMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
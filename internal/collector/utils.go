@@ -19,13 +19,16 @@ package collector
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	v1 "k8s.io/api/core/v1"
 
+	"k8s.io/kube-state-metrics/pkg/constant"
 	"k8s.io/kube-state-metrics/pkg/metric"
 )
 
@@ -41,6 +44,16 @@ func boolFloat64(b bool) float64 {
 	return 0
 }
 
+// quantityFloat64 returns the quantity as a float64. Unlike MilliValue it does
+// not overflow for quantities beyond the int64 range.
+func quantityFloat64(q resource.Quantity) float64 {
+	f, err := strconv.ParseFloat(q.AsDec().String(), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
 // addConditionMetrics generates one metric for each possible node condition
 // status. For this function to work properly, the last label in the metric
 // description must be the condition.
@@ -62,11 +75,17 @@ func addConditionMetrics(cs v1.ConditionStatus) []*metric.Metric {
 }
 
 func kubeLabelsToPrometheusLabels(labels map[string]string) ([]string, []string) {
-	labelKeys := make([]string, len(labels))
-	labelValues := make([]string, len(labels))
+	return mapToPrometheusLabels(labels, "label")
+}
+
+// mapToPrometheusLabels converts the given map into Prometheus label keys and
+// values, prefixing and sanitizing each key.
+func mapToPrometheusLabels(m map[string]string, prefix string) ([]string, []string) {
+	labelKeys := make([]string, len(m))
+	labelValues := make([]string, len(m))
 	i := 0
-	for k, v := range labels {
-		labelKeys[i] = "label_" + sanitizeLabelName(k)
+	for k, v := range m {
+		labelKeys[i] = prefix + "_" + sanitizeLabelName(k)
 		labelValues[i] = v
 		i++
 	}
@@ -117,3 +136,83 @@ func isNativeResource(name v1.ResourceName) bool {
 func isPrefixedNativeResource(name v1.ResourceName) bool {
 	return strings.Contains(string(name), v1.ResourceDefaultNamespacePrefix)
 }
+
+type resourceKey struct {
+	resource string
+	unit     constant.ResourceUnit
+}
+
+// containerResourceValue returns the key and value of the given container
+// resource as exposed by the pod container resource families, with CPU in
+// millicores. Resources not exposed by these families are skipped.
+func containerResourceValue(name v1.ResourceName, val resource.Quantity) (resourceKey, int64, bool) {
+	switch {
+	case name == v1.ResourceCPU:
+		return resourceKey{sanitizeLabelName(string(name)), constant.UnitCore}, val.MilliValue(), true
+	case name == v1.ResourceStorage, name == v1.ResourceEphemeralStorage, name == v1.ResourceMemory,
+		isHugePageResourceName(name), isAttachableVolumeResourceName(name):
+		return resourceKey{sanitizeLabelName(string(name)), constant.UnitByte}, val.Value(), true
+	case isExtendedResourceName(name):
+		return resourceKey{sanitizeLabelName(string(name)), constant.UnitInteger}, val.Value(), true
+	default:
+		return resourceKey{}, 0, false
+	}
+}
+
+// podEffectiveResources returns the requests and limits the scheduler charges
+// the given pod with, i.e. per resource the larger of the sum over all
+// containers and the maximum over all init containers, as init containers run
+// one after another before the containers are started.
+func podEffectiveResources(p *v1.Pod) (map[resourceKey]int64, map[resourceKey]int64) {
+	requests := map[resourceKey]int64{}
+	limits := map[resourceKey]int64{}
+
+	for _, c := range p.Spec.Containers {
+		for name, val := range c.Resources.Requests {
+			if k, v, ok := containerResourceValue(name, val); ok {
+				requests[k] += v
+			}
+		}
+		for name, val := range c.Resources.Limits {
+			if k, v, ok := containerResourceValue(name, val); ok {
+				limits[k] += v
+			}
+		}
+	}
+
+	for _, c := range p.Spec.InitContainers {
+		for name, val := range c.Resources.Requests {
+			if k, v, ok := containerResourceValue(name, val); ok && v > requests[k] {
+				requests[k] = v
+			}
+		}
+		for name, val := range c.Resources.Limits {
+			if k, v, ok := containerResourceValue(name, val); ok && v > limits[k] {
+				limits[k] = v
+			}
+		}
+	}
+
+	return requests, limits
+}
+
+// resourceMetrics returns one metric per resource of the given sums, as
+// returned by containerResourceValue.
+func resourceMetrics(sums map[resourceKey]int64) []*metric.Metric {
+	ms := make([]*metric.Metric, 0, len(sums))
+
+	for k, v := range sums {
+		value := float64(v)
+		if k.unit == constant.UnitCore {
+			// CPU is kept in millicores to avoid rounding errors.
+			value = value / 1000
+		}
+		ms = append(ms, &metric.Metric{
+			LabelKeys:   []string{"resource", "unit"},
+			LabelValues: []string{k.resource, string(k.unit)},
+			Value:       value,
+		})
+	}
+
+	return ms
+}
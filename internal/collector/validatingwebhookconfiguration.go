@@ -0,0 +1,150 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descValidatingWebhookConfigurationDefaultLabels = []string{"validatingwebhookconfiguration"}
+
+	validatingWebhookConfigurationMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_validatingwebhookconfiguration_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapValidatingWebhookConfigurationFunc(func(c *admissionregistration.ValidatingWebhookConfiguration) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !c.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(c.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: "kube_validatingwebhookconfiguration_webhook_info",
+			Type: metric.Gauge,
+			Help: "Information about a webhook of the validatingwebhookconfiguration.",
+			GenerateFunc: wrapValidatingWebhookConfigurationFunc(func(c *admissionregistration.ValidatingWebhookConfiguration) *metric.Family {
+				return &metric.Family{
+					Metrics: webhookInfoMetrics(c.Webhooks),
+				}
+			}),
+		},
+		{
+			Name: "kube_validatingwebhookconfiguration_webhook_namespace_selector",
+			Type: metric.Gauge,
+			Help: "Whether a webhook of the validatingwebhookconfiguration restricts the namespaces it applies to.",
+			GenerateFunc: wrapValidatingWebhookConfigurationFunc(func(c *admissionregistration.ValidatingWebhookConfiguration) *metric.Family {
+				return &metric.Family{
+					Metrics: webhookNamespaceSelectorMetrics(c.Webhooks),
+				}
+			}),
+		},
+	}
+)
+
+// webhookInfoMetrics returns one info metric per webhook, describing its
+// failure policy and the service or URL it calls.
+func webhookInfoMetrics(webhooks []admissionregistration.Webhook) []*metric.Metric {
+	ms := make([]*metric.Metric, len(webhooks))
+
+	for i, w := range webhooks {
+		// Fall back to the API server default for an unset failure policy.
+		failurePolicy := admissionregistration.Ignore
+		if w.FailurePolicy != nil {
+			failurePolicy = *w.FailurePolicy
+		}
+
+		var serviceNamespace, serviceName, servicePath, url string
+		if s := w.ClientConfig.Service; s != nil {
+			serviceNamespace = s.Namespace
+			serviceName = s.Name
+			if s.Path != nil {
+				servicePath = *s.Path
+			}
+		}
+		if w.ClientConfig.URL != nil {
+			url = *w.ClientConfig.URL
+		}
+
+		ms[i] = &metric.Metric{
+			LabelKeys:   []string{"webhook", "failure_policy", "service_namespace", "service_name", "service_path", "url"},
+			LabelValues: []string{w.Name, string(failurePolicy), serviceNamespace, serviceName, servicePath, url},
+			Value:       1,
+		}
+	}
+
+	return ms
+}
+
+// webhookNamespaceSelectorMetrics returns one metric per webhook, set to 1
+// if the webhook has a non-empty namespace selector.
+func webhookNamespaceSelectorMetrics(webhooks []admissionregistration.Webhook) []*metric.Metric {
+	ms := make([]*metric.Metric, len(webhooks))
+
+	for i, w := range webhooks {
+		s := w.NamespaceSelector
+		ms[i] = &metric.Metric{
+			LabelKeys:   []string{"webhook"},
+			LabelValues: []string{w.Name},
+			Value:       boolFloat64(s != nil && (len(s.MatchLabels) > 0 || len(s.MatchExpressions) > 0)),
+		}
+	}
+
+	return ms
+}
+
+func wrapValidatingWebhookConfigurationFunc(f func(*admissionregistration.ValidatingWebhookConfiguration) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		validatingWebhookConfiguration := obj.(*admissionregistration.ValidatingWebhookConfiguration)
+
+		metricFamily := f(validatingWebhookConfiguration)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descValidatingWebhookConfigurationDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{validatingWebhookConfiguration.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createValidatingWebhookConfigurationListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+var (
+	webhookFailurePolicyFail = admissionregistration.Fail
+	webhookServicePath       = "/validate"
+	webhookURL               = "https://webhook.example.com/validate"
+)
+
+func TestValidatingWebhookConfigurationCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_validatingwebhookconfiguration_created Unix creation timestamp
+		# TYPE kube_validatingwebhookconfiguration_created gauge
+		# HELP kube_validatingwebhookconfiguration_webhook_info Information about a webhook of the validatingwebhookconfiguration.
+		# TYPE kube_validatingwebhookconfiguration_webhook_info gauge
+		# HELP kube_validatingwebhookconfiguration_webhook_namespace_selector Whether a webhook of the validatingwebhookconfiguration restricts the namespaces it applies to.
+		# TYPE kube_validatingwebhookconfiguration_webhook_namespace_selector gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &admissionregistration.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "policy",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Webhooks: []admissionregistration.Webhook{
+					{
+						Name:          "pods.policy.example.com",
+						FailurePolicy: &webhookFailurePolicyFail,
+						ClientConfig: admissionregistration.WebhookClientConfig{
+							Service: &admissionregistration.ServiceReference{
+								Namespace: "policy-system",
+								Name:      "policy-webhook",
+								Path:      &webhookServicePath,
+							},
+						},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"policy": "enabled",
+							},
+						},
+					},
+					{
+						Name: "external.policy.example.com",
+						ClientConfig: admissionregistration.WebhookClientConfig{
+							URL: &webhookURL,
+						},
+						NamespaceSelector: &metav1.LabelSelector{},
+					},
+				},
+			},
+			Want: `
+				kube_validatingwebhookconfiguration_created{validatingwebhookconfiguration="policy"} 1.5e+09
+				kube_validatingwebhookconfiguration_webhook_info{failure_policy="Fail",service_name="policy-webhook",service_namespace="policy-system",service_path="/validate",url="",validatingwebhookconfiguration="policy",webhook="pods.policy.example.com"} 1
+				kube_validatingwebhookconfiguration_webhook_info{failure_policy="Ignore",service_name="",service_namespace="",service_path="",url="https://webhook.example.com/validate",validatingwebhookconfiguration="policy",webhook="external.policy.example.com"} 1
+				kube_validatingwebhookconfiguration_webhook_namespace_selector{validatingwebhookconfiguration="policy",webhook="external.policy.example.com"} 0
+				kube_validatingwebhookconfiguration_webhook_namespace_selector{validatingwebhookconfiguration="policy",webhook="pods.policy.example.com"} 1
+`,
+		},
+		{
+			Obj: &admissionregistration.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "empty",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+			},
+			Want: `
+				kube_validatingwebhookconfiguration_created{validatingwebhookconfiguration="empty"} 1.5e+09
+`,
+			MetricNames: []string{
+				"kube_validatingwebhookconfiguration_created",
+				"kube_validatingwebhookconfiguration_webhook_info",
+				"kube_validatingwebhookconfiguration_webhook_namespace_selector",
+			},
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(validatingWebhookConfigurationMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"k8s.io/kube-state-metrics/pkg/metric"
+
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	descVolumeAttachmentLabelsName          = "kube_volumeattachment_labels"
+	descVolumeAttachmentLabelsHelp          = "Kubernetes labels converted to Prometheus labels."
+	descVolumeAttachmentLabelsDefaultLabels = []string{"volumeattachment"}
+
+	volumeAttachmentMetricFamilies = []metric.FamilyGenerator{
+		{
+			Name: "kube_volumeattachment_info",
+			Type: metric.Gauge,
+			Help: "Information about volumeattachment.",
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				persistentVolume := ""
+				if va.Spec.Source.PersistentVolumeName != nil {
+					persistentVolume = *va.Spec.Source.PersistentVolumeName
+				}
+
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   []string{"attacher", "node", "persistentvolume"},
+							LabelValues: []string{va.Spec.Attacher, va.Spec.NodeName, persistentVolume},
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_volumeattachment_created",
+			Type: metric.Gauge,
+			Help: "Unix creation timestamp",
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				ms := []*metric.Metric{}
+
+				if !va.CreationTimestamp.IsZero() {
+					ms = append(ms, &metric.Metric{
+						Value: float64(va.CreationTimestamp.Unix()),
+					})
+				}
+
+				return &metric.Family{
+					Metrics: ms,
+				}
+			}),
+		},
+		{
+			Name: descVolumeAttachmentLabelsName,
+			Type: metric.Gauge,
+			Help: descVolumeAttachmentLabelsHelp,
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				labelKeys, labelValues := kubeLabelsToPrometheusLabels(va.Labels)
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							LabelKeys:   labelKeys,
+							LabelValues: labelValues,
+							Value:       1,
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_volumeattachment_status_attached",
+			Type: metric.Gauge,
+			Help: "Whether the volume is successfully attached to the node.",
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				return &metric.Family{
+					Metrics: []*metric.Metric{
+						{
+							Value: boolFloat64(va.Status.Attached),
+						},
+					},
+				}
+			}),
+		},
+		{
+			Name: "kube_volumeattachment_status_attach_error_time",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last error encountered while attaching the volume, with the error message.",
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				return &metric.Family{
+					Metrics: volumeErrorMetrics(va.Status.AttachError),
+				}
+			}),
+		},
+		{
+			Name: "kube_volumeattachment_status_detach_error_time",
+			Type: metric.Gauge,
+			Help: "Unix timestamp of the last error encountered while detaching the volume, with the error message.",
+			GenerateFunc: wrapVolumeAttachmentFunc(func(va *storagev1beta1.VolumeAttachment) *metric.Family {
+				return &metric.Family{
+					Metrics: volumeErrorMetrics(va.Status.DetachError),
+				}
+			}),
+		},
+	}
+)
+
+// volumeErrorMetrics returns a metric carrying the error message and time of
+// the given volume error, or none if there is no error.
+func volumeErrorMetrics(e *storagev1beta1.VolumeError) []*metric.Metric {
+	if e == nil {
+		return []*metric.Metric{}
+	}
+
+	var t float64
+	if !e.Time.IsZero() {
+		t = float64(e.Time.Unix())
+	}
+
+	return []*metric.Metric{
+		{
+			LabelKeys:   []string{"message"},
+			LabelValues: []string{e.Message},
+			Value:       t,
+		},
+	}
+}
+
+func wrapVolumeAttachmentFunc(f func(*storagev1beta1.VolumeAttachment) *metric.Family) func(interface{}) *metric.Family {
+	return func(obj interface{}) *metric.Family {
+		volumeAttachment := obj.(*storagev1beta1.VolumeAttachment)
+
+		metricFamily := f(volumeAttachment)
+
+		for _, m := range metricFamily.Metrics {
+			m.LabelKeys = append(descVolumeAttachmentLabelsDefaultLabels, m.LabelKeys...)
+			m.LabelValues = append([]string{volumeAttachment.Name}, m.LabelValues...)
+		}
+
+		return metricFamily
+	}
+}
+
+func createVolumeAttachmentListWatch(kubeClient clientset.Interface, ns string) cache.ListWatch {
+	return cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			return kubeClient.StorageV1beta1().VolumeAttachments().List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			return kubeClient.StorageV1beta1().VolumeAttachments().Watch(opts)
+		},
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2019 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-state-metrics/pkg/metric"
+)
+
+var (
+	volumeAttachment1PersistentVolume = "pv-1"
+)
+
+func TestVolumeAttachmentCollector(t *testing.T) {
+	// Fixed metadata on type and help text. We prepend this to every expected
+	// output so we only have to modify a single place when doing adjustments.
+	const metadata = `
+		# HELP kube_volumeattachment_info Information about volumeattachment.
+		# TYPE kube_volumeattachment_info gauge
+		# HELP kube_volumeattachment_created Unix creation timestamp
+		# TYPE kube_volumeattachment_created gauge
+		# HELP kube_volumeattachment_labels Kubernetes labels converted to Prometheus labels.
+		# TYPE kube_volumeattachment_labels gauge
+		# HELP kube_volumeattachment_status_attached Whether the volume is successfully attached to the node.
+		# TYPE kube_volumeattachment_status_attached gauge
+		# HELP kube_volumeattachment_status_attach_error_time Unix timestamp of the last error encountered while attaching the volume, with the error message.
+		# TYPE kube_volumeattachment_status_attach_error_time gauge
+		# HELP kube_volumeattachment_status_detach_error_time Unix timestamp of the last error encountered while detaching the volume, with the error message.
+		# TYPE kube_volumeattachment_status_detach_error_time gauge
+	`
+	cases := []generateMetricsTestCase{
+		{
+			Obj: &storagev1beta1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "csi-1",
+					CreationTimestamp: metav1.Time{Time: time.Unix(1500000000, 0)},
+				},
+				Spec: storagev1beta1.VolumeAttachmentSpec{
+					Attacher: "csi.example.com",
+					NodeName: "node1",
+					Source: storagev1beta1.VolumeAttachmentSource{
+						PersistentVolumeName: &volumeAttachment1PersistentVolume,
+					},
+				},
+				Status: storagev1beta1.VolumeAttachmentStatus{
+					Attached: true,
+				},
+			},
+			Want: `
+				kube_volumeattachment_info{attacher="csi.example.com",node="node1",persistentvolume="pv-1",volumeattachment="csi-1"} 1
+				kube_volumeattachment_created{volumeattachment="csi-1"} 1.5e+09
+				kube_volumeattachment_labels{volumeattachment="csi-1"} 1
+				kube_volumeattachment_status_attached{volumeattachment="csi-1"} 1
+`,
+		},
+		{
+			Obj: &storagev1beta1.VolumeAttachment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "csi-2",
+				},
+				Spec: storagev1beta1.VolumeAttachmentSpec{
+					Attacher: "csi.example.com",
+					NodeName: "node2",
+				},
+				Status: storagev1beta1.VolumeAttachmentStatus{
+					AttachError: &storagev1beta1.VolumeError{
+						Time:    metav1.Time{Time: time.Unix(1500000100, 0)},
+						Message: "volume is attached to another node",
+					},
+					DetachError: &storagev1beta1.VolumeError{
+						Message: "timed out waiting for detach",
+					},
+				},
+			},
+			Want: `
+				kube_volumeattachment_info{attacher="csi.example.com",node="node2",persistentvolume="",volumeattachment="csi-2"} 1
+				kube_volumeattachment_status_attached{volumeattachment="csi-2"} 0
+				kube_volumeattachment_status_attach_error_time{message="volume is attached to another node",volumeattachment="csi-2"} 1.5000001e+09
+				kube_volumeattachment_status_detach_error_time{message="timed out waiting for detach",volumeattachment="csi-2"} 0
+`,
+			MetricNames: []string{
+				"kube_volumeattachment_info",
+				"kube_volumeattachment_status_",
+			},
+		},
+	}
+	for i, c := range cases {
+		c.Func = metric.ComposeMetricGenFuncs(volumeAttachmentMetricFamilies)
+		if err := c.run(); err != nil {
+			t.Errorf("unexpected collecting result in %vth run:\n%s", i, err)
+		}
+	}
+}
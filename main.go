@@ -81,13 +81,14 @@ func main() {
 
 	collectorBuilder := kcoll.NewBuilder(ctx)
 
+	enabledCollectors := options.DefaultCollectors.AsSlice()
 	if len(opts.Collectors) == 0 {
 		klog.Info("Using default collectors")
-		collectorBuilder.WithEnabledCollectors(options.DefaultCollectors.AsSlice())
 	} else {
 		klog.Infof("Using collectors %s", opts.Collectors.String())
-		collectorBuilder.WithEnabledCollectors(opts.Collectors.AsSlice())
+		enabledCollectors = opts.Collectors.AsSlice()
 	}
+	collectorBuilder.WithEnabledCollectors(enabledCollectors)
 
 	if len(opts.Namespaces) == 0 {
 		klog.Info("Using all namespace")
@@ -126,9 +127,34 @@ func main() {
 		})
 	}
 
+	if !opts.EnableEndpointAddressMetrics {
+		whiteBlackList.Exclude([]string{
+			"kube_endpoint_address_info",
+		})
+	}
+
+	if !opts.EnableNamespaceAggregateMetrics {
+		whiteBlackList.Exclude([]string{
+			"kube_namespace_pods_phase",
+			"kube_namespace_pod_container_resource_requests",
+			"kube_namespace_pod_container_resource_limits",
+			"kube_namespace_pod_container_status_restarts",
+		})
+	}
+
 	klog.Infof("metric white-blacklisting: %v", whiteBlackList.Status())
 
 	collectorBuilder.WithWhiteBlackList(whiteBlackList)
+	collectorBuilder.WithEventReasons(opts.EventReasons)
+	collectorBuilder.WithComponentStatusPollInterval(opts.ComponentStatusPollInterval)
+
+	err = kcoll.ValidatePodLabelEnrichment(enabledCollectors, opts.PodNodeLabels, opts.PodNamespaceLabels, opts.PodLabelEnrichmentMetrics)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	collectorBuilder.WithPodNodeLabels(opts.PodNodeLabels)
+	collectorBuilder.WithPodNamespaceLabels(opts.PodNamespaceLabels)
+	collectorBuilder.WithPodLabelEnrichmentMetrics(opts.PodLabelEnrichmentMetrics)
 
 	proc.StartReaper()
 
@@ -248,6 +248,20 @@ kube_pod_container_resource_limits{namespace="default",pod="pod0",container="pod
 kube_pod_container_resource_limits{namespace="default",pod="pod0",container="pod1_con1",node="node1",resource="storage",unit="byte"} 4e+08
 kube_pod_container_resource_limits{namespace="default",pod="pod0",container="pod1_con2",node="node1",resource="memory",unit="byte"} 2e+08
 kube_pod_container_resource_limits{namespace="default",pod="pod0",container="pod1_con2",node="node1",resource="cpu",unit="core"} 0.3
+# HELP kube_pod_resource_requests The effective resources requested by a pod as accounted by the scheduler, the larger of the sum over all containers and the maximum over all init containers.
+# TYPE kube_pod_resource_requests gauge
+kube_pod_resource_requests{namespace="default",pod="pod0",node="node1",resource="nvidia_com_gpu",unit="integer"} 1
+kube_pod_resource_requests{namespace="default",pod="pod0",node="node1",resource="cpu",unit="core"} 0.5
+kube_pod_resource_requests{namespace="default",pod="pod0",node="node1",resource="memory",unit="byte"} 3e+08
+kube_pod_resource_requests{namespace="default",pod="pod0",node="node1",resource="ephemeral_storage",unit="byte"} 3e+08
+kube_pod_resource_requests{namespace="default",pod="pod0",node="node1",resource="storage",unit="byte"} 4e+08
+# HELP kube_pod_resource_limits The effective resource limits of a pod, the larger of the sum over all containers and the maximum over all init containers.
+# TYPE kube_pod_resource_limits gauge
+kube_pod_resource_limits{namespace="default",pod="pod0",node="node1",resource="nvidia_com_gpu",unit="integer"} 1
+kube_pod_resource_limits{namespace="default",pod="pod0",node="node1",resource="cpu",unit="core"} 0.5
+kube_pod_resource_limits{namespace="default",pod="pod0",node="node1",resource="memory",unit="byte"} 3e+08
+kube_pod_resource_limits{namespace="default",pod="pod0",node="node1",resource="ephemeral_storage",unit="byte"} 3e+08
+kube_pod_resource_limits{namespace="default",pod="pod0",node="node1",resource="storage",unit="byte"} 4e+08
 # HELP kube_pod_container_resource_requests_cpu_cores The number of requested cpu cores by a container.
 # TYPE kube_pod_container_resource_requests_cpu_cores gauge
 kube_pod_container_resource_requests_cpu_cores{namespace="default",pod="pod0",container="pod1_con1",node="node1"} 0.2
@@ -267,7 +281,10 @@ kube_pod_container_resource_limits_memory_bytes{namespace="default",pod="pod0",c
 # HELP kube_pod_spec_volumes_persistentvolumeclaims_info Information about persistentvolumeclaim volumes in a pod.
 # TYPE kube_pod_spec_volumes_persistentvolumeclaims_info gauge
 # HELP kube_pod_spec_volumes_persistentvolumeclaims_readonly Describes whether a persistentvolumeclaim is mounted read only.
-# TYPE kube_pod_spec_volumes_persistentvolumeclaims_readonly gauge`
+# TYPE kube_pod_spec_volumes_persistentvolumeclaims_readonly gauge
+# HELP kube_pod_controller Information about the top-level controller of the Pod, resolved along the chain of controlling owners.
+# TYPE kube_pod_controller gauge
+kube_pod_controller{namespace="default",pod="pod0",controller_kind="<none>",controller_name="<none>"} 1`
 
 	expectedSplit := strings.Split(strings.TrimSpace(expected), "\n")
 	sort.Strings(expectedSplit)
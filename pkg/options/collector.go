@@ -17,6 +17,8 @@ limitations under the License.
 package options
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,26 +28,65 @@ var (
 
 	// DefaultCollectors represents the default set of collectors in kube-state-metrics.
 	DefaultCollectors = CollectorSet{
-		"configmaps":               struct{}{},
-		"cronjobs":                 struct{}{},
-		"daemonsets":               struct{}{},
-		"deployments":              struct{}{},
-		"endpoints":                struct{}{},
-		"horizontalpodautoscalers": struct{}{},
-		"ingresses":                struct{}{},
-		"jobs":                     struct{}{},
-		"limitranges":              struct{}{},
-		"namespaces":               struct{}{},
-		"nodes":                    struct{}{},
-		"persistentvolumes":        struct{}{},
-		"persistentvolumeclaims":   struct{}{},
-		"poddisruptionbudgets":     struct{}{},
-		"pods":                     struct{}{},
-		"replicasets":              struct{}{},
-		"replicationcontrollers":   struct{}{},
-		"resourcequotas":           struct{}{},
-		"secrets":                  struct{}{},
-		"services":                 struct{}{},
-		"statefulsets":             struct{}{},
+		"certificatesigningrequests":      struct{}{},
+		"configmaps":                      struct{}{},
+		"cronjobs":                        struct{}{},
+		"daemonsets":                      struct{}{},
+		"deployments":                     struct{}{},
+		"endpoints":                       struct{}{},
+		"horizontalpodautoscalers":        struct{}{},
+		"ingresses":                       struct{}{},
+		"jobs":                            struct{}{},
+		"limitranges":                     struct{}{},
+		"mutatingwebhookconfigurations":   struct{}{},
+		"namespaces":                      struct{}{},
+		"networkpolicies":                 struct{}{},
+		"nodes":                           struct{}{},
+		"persistentvolumes":               struct{}{},
+		"persistentvolumeclaims":          struct{}{},
+		"poddisruptionbudgets":            struct{}{},
+		"pods":                            struct{}{},
+		"replicasets":                     struct{}{},
+		"replicationcontrollers":          struct{}{},
+		"resourcequotas":                  struct{}{},
+		"secrets":                         struct{}{},
+		"serviceaccounts":                 struct{}{},
+		"services":                        struct{}{},
+		"statefulsets":                    struct{}{},
+		"storageclasses":                  struct{}{},
+		"validatingwebhookconfigurations": struct{}{},
+	}
+	// OptInCollectors represents the collectors which are available in
+	// kube-state-metrics, but have to be enabled explicitly.
+	OptInCollectors = CollectorSet{
+		"clusterrolebindings": struct{}{},
+		"clusterroles":        struct{}{},
+		"componentstatuses":   struct{}{},
+		"events":              struct{}{},
+		"priorityclasses":     struct{}{},
+		"rolebindings":        struct{}{},
+		"roles":               struct{}{},
+		"volumeattachments":   struct{}{},
+	}
+
+	// DefaultComponentStatusPollInterval is the default interval at which the
+	// componentstatuses collector polls the API server.
+	DefaultComponentStatusPollInterval = time.Minute
+
+	// DefaultPodLabelEnrichmentMetrics is the default set of pod metrics node
+	// and namespace labels are copied onto.
+	DefaultPodLabelEnrichmentMetrics = []string{"kube_pod_info"}
+
+	// DefaultEventReasons is the default allow list of event reasons
+	// aggregated by the events collector.
+	DefaultEventReasons = []string{
+		"BackOff",
+		"Evicted",
+		"FailedAttachVolume",
+		"FailedCreatePodSandBox",
+		"FailedMount",
+		"FailedScheduling",
+		"OOMKilling",
+		"Unhealthy",
 	}
 )
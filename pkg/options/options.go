@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -40,6 +41,13 @@ type Options struct {
 	Version                              bool
 	DisablePodNonGenericResourceMetrics  bool
 	DisableNodeNonGenericResourceMetrics bool
+	EnableEndpointAddressMetrics         bool
+	EnableNamespaceAggregateMetrics      bool
+	EventReasons                         []string
+	ComponentStatusPollInterval          time.Duration
+	PodNodeLabels                        []string
+	PodNamespaceLabels                   []string
+	PodLabelEnrichmentMetrics            []string
 
 	EnableGZIPEncoding bool
 
@@ -83,13 +91,28 @@ func (o *Options) AddFlags() {
 	o.flags.BoolVarP(&o.Version, "version", "", false, "kube-state-metrics build version information")
 	o.flags.BoolVarP(&o.DisablePodNonGenericResourceMetrics, "disable-pod-non-generic-resource-metrics", "", false, "Disable pod non generic resource request and limit metrics")
 	o.flags.BoolVarP(&o.DisableNodeNonGenericResourceMetrics, "disable-node-non-generic-resource-metrics", "", false, "Disable node non generic resource request and limit metrics")
+	o.flags.BoolVarP(&o.EnableEndpointAddressMetrics, "enable-endpoint-address-metrics", "", false, "Enable the per address endpoint metrics, which can have a high cardinality")
+	o.flags.StringSliceVar(&o.EventReasons, "event-reasons", DefaultEventReasons, "Comma-separated list of event reasons aggregated by the events collector. An empty list aggregates events of any reason, which can have a high cardinality.")
+	o.flags.DurationVar(&o.ComponentStatusPollInterval, "componentstatus-poll-interval", DefaultComponentStatusPollInterval, "Interval at which the componentstatuses collector polls the API server.")
+	o.flags.StringSliceVar(&o.PodNodeLabels, "pod-node-labels", nil, "Comma-separated list of node labels to copy onto the pod metrics selected with --pod-label-enrichment-metrics, e.g. the zone or instance type of the node the pod runs on. Requires the nodes collector.")
+	o.flags.StringSliceVar(&o.PodNamespaceLabels, "pod-namespace-labels", nil, "Comma-separated list of namespace labels to copy onto the pod metrics selected with --pod-label-enrichment-metrics. Requires the namespaces collector.")
+	o.flags.StringSliceVar(&o.PodLabelEnrichmentMetrics, "pod-label-enrichment-metrics", DefaultPodLabelEnrichmentMetrics, "Comma-separated list of pod metrics to copy the labels selected with --pod-node-labels and --pod-namespace-labels onto.")
+	o.flags.BoolVarP(&o.EnableNamespaceAggregateMetrics, "enable-namespace-aggregate-metrics", "", false, "Enable the metrics aggregating the phases, container resources and restarts of pods per namespace")
 	o.flags.BoolVar(&o.EnableGZIPEncoding, "enable-gzip-encoding", false, "Gzip responses when requested by clients via 'Accept-Encoding: gzip' header.")
 }
 
 // Parse parses the flag definitions from the argument list.
 func (o *Options) Parse() error {
 	err := o.flags.Parse(os.Args)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if o.ComponentStatusPollInterval <= 0 {
+		return fmt.Errorf("componentstatus poll interval must be positive, got %v", o.ComponentStatusPollInterval)
+	}
+
+	return nil
 }
 
 // Usage is the function called when an error occurs while parsing flags.
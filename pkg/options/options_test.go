@@ -73,3 +73,47 @@ func TestOptionsParse(t *testing.T) {
 		}
 	}
 }
+
+func TestOptionsParseComponentStatusPollInterval(t *testing.T) {
+	tests := []struct {
+		Desc    string
+		Args    []string
+		WantErr bool
+	}{
+		{
+			Desc:    "default interval",
+			Args:    []string{"./kube-state-metrics"},
+			WantErr: false,
+		},
+		{
+			Desc:    "positive interval",
+			Args:    []string{"./kube-state-metrics", "--componentstatus-poll-interval=30s"},
+			WantErr: false,
+		},
+		{
+			Desc:    "zero interval",
+			Args:    []string{"./kube-state-metrics", "--componentstatus-poll-interval=0s"},
+			WantErr: true,
+		},
+		{
+			Desc:    "negative interval",
+			Args:    []string{"./kube-state-metrics", "--componentstatus-poll-interval=-1m"},
+			WantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		opts := NewOptions()
+		opts.AddFlags()
+
+		os.Args = test.Args
+
+		err := opts.Parse()
+		if test.WantErr && err == nil {
+			t.Errorf("Test error for Desc: %s. Expected an error, got none", test.Desc)
+		}
+		if !test.WantErr && err != nil {
+			t.Errorf("Test error for Desc: %s. Unexpected error: %v", test.Desc, err)
+		}
+	}
+}
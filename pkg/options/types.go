@@ -84,8 +84,9 @@ func (c *CollectorSet) Set(value string) error {
 	for _, col := range cols {
 		col = strings.TrimSpace(col)
 		if len(col) != 0 {
-			_, ok := DefaultCollectors[col]
-			if !ok {
+			_, isDefault := DefaultCollectors[col]
+			_, isOptIn := OptInCollectors[col]
+			if !isDefault && !isOptIn {
 				return fmt.Errorf("collector \"%s\" does not exist", col)
 			}
 			s[col] = struct{}{}
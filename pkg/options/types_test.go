@@ -45,6 +45,15 @@ func TestCollectorSetSet(t *testing.T) {
 			}),
 			WantedError: false,
 		},
+		{
+			Desc:  "opt-in collectors",
+			Value: "configmaps,roles",
+			Wanted: CollectorSet(map[string]struct{}{
+				"configmaps": {},
+				"roles":      {},
+			}),
+			WantedError: false,
+		},
 		{
 			Desc:        "none exist collectors",
 			Value:       "none-exists",
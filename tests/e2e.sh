@@ -168,9 +168,15 @@ echo "check metrics format with promtool"
 [[ -n "$E2E_SETUP_PROMTOOL" ]] && setup_promtool
 < ${KUBE_STATE_METRICS_LOG_DIR}/metrics promtool check metrics
 
-collectors=$(find internal/collector/ -maxdepth 1 -name "*.go" -not -name "*_test.go" -not -name "builder.go" -not -name "testutils.go" -not -name "utils.go" -print0 | xargs -0 -n1 basename | awk -F. '{print $1}')
+collectors=$(find internal/collector/ -maxdepth 1 -name "*.go" -not -name "*_test.go" -not -name "builder.go" -not -name "namespaceaggregate.go" -not -name "nodeaggregate.go" -not -name "owner.go" -not -name "podaggregate.go" -not -name "podlabelenrichment.go" -not -name "testutils.go" -not -name "utils.go" -print0 | xargs -0 -n1 basename | awk -F. '{print $1}')
 echo "available collectors: $collectors"
+# opt-in collectors are not enabled in the e2e deployment
+optin_collectors="clusterrole clusterrolebinding componentstatus event priorityclass role rolebinding volumeattachment"
 for collector in ${collectors}; do
+    if [[ " ${optin_collectors} " == *" ${collector} "* ]]; then
+        echo "skipping opt-in collector ${collector}"
+        continue
+    fi
     echo "checking that kube_${collector}* metrics exists"
     grep "^kube_${collector}_" ${KUBE_STATE_METRICS_LOG_DIR}/metrics
 done